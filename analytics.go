@@ -0,0 +1,588 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// Analytics service, for the WooCommerce Analytics reports (wc-analytics namespace)
+type AnalyticsService service
+
+// Analytics intervals
+const (
+  AnalyticsIntervalHour    = "hour"
+  AnalyticsIntervalDay     = "day"
+  AnalyticsIntervalWeek    = "week"
+  AnalyticsIntervalMonth   = "month"
+  AnalyticsIntervalQuarter = "quarter"
+  AnalyticsIntervalYear    = "year"
+)
+
+// Analytics stats segments
+const (
+  AnalyticsSegmentByProduct      = "product"
+  AnalyticsSegmentByCategory     = "category"
+  AnalyticsSegmentByVariation    = "variation"
+  AnalyticsSegmentByCoupon       = "coupon"
+  AnalyticsSegmentByCustomerType = "customer_type"
+)
+
+// AnalyticsStats is a stats report, with its totals for the whole period and for each interval
+type AnalyticsStats struct {
+  Totals     *AnalyticsStatsTotals  `json:"totals,omitempty"`
+  Intervals  []AnalyticsInterval    `json:"intervals,omitempty"`
+}
+
+// AnalyticsInterval holds the totals of an interval (eg. a day, when the interval is "day")
+type AnalyticsInterval struct {
+  Interval      string                 `json:"interval,omitempty"`
+  DateStart     string                 `json:"date_start,omitempty"`
+  DateStartGmt  string                 `json:"date_start_gmt,omitempty"`
+  DateEnd       string                 `json:"date_end,omitempty"`
+  DateEndGmt    string                 `json:"date_end_gmt,omitempty"`
+  Subtotals     *AnalyticsStatsTotals  `json:"subtotals,omitempty"`
+}
+
+// AnalyticsStatsTotals are the totals of the revenue and orders stats reports. Segments are set when
+// the report is segmented (see SegmentBy).
+type AnalyticsStatsTotals struct {
+  OrdersCount            int                 `json:"orders_count,omitempty"`
+  NumItemsSold           int                 `json:"num_items_sold,omitempty"`
+  GrossSales             float64             `json:"gross_sales,omitempty"`
+  TotalSales             float64             `json:"total_sales,omitempty"`
+  Coupons                float64             `json:"coupons,omitempty"`
+  CouponsCount           int                 `json:"coupons_count,omitempty"`
+  Refunds                float64             `json:"refunds,omitempty"`
+  Taxes                  float64             `json:"taxes,omitempty"`
+  Shipping               float64             `json:"shipping,omitempty"`
+  NetRevenue             float64             `json:"net_revenue,omitempty"`
+  AvgItemsPerOrder       float64             `json:"avg_items_per_order,omitempty"`
+  AvgOrderValue          float64             `json:"avg_order_value,omitempty"`
+  NumReturningCustomers  int                 `json:"num_returning_customers,omitempty"`
+  NumNewCustomers        int                 `json:"num_new_customers,omitempty"`
+  Products               int                 `json:"products,omitempty"`
+  Segments               []AnalyticsSegment  `json:"segments,omitempty"`
+}
+
+// AnalyticsSegment holds the totals of a segment. SegmentId is the product, category, variation
+// or coupon ID, or the customer type (eg. "new").
+type AnalyticsSegment struct {
+  SegmentId     interface{}            `json:"segment_id,omitempty"`
+  SegmentLabel  string                 `json:"segment_label,omitempty"`
+  Subtotals     *AnalyticsStatsTotals  `json:"subtotals,omitempty"`
+}
+
+// AnalyticsProduct object, listed by the products and variations reports
+type AnalyticsProduct struct {
+  ProductId     int                    `json:"product_id,omitempty"`
+  VariationId   int                    `json:"variation_id,omitempty"`
+  ItemsSold     int                    `json:"items_sold,omitempty"`
+  NetRevenue    float64                `json:"net_revenue,omitempty"`
+  OrdersCount   int                    `json:"orders_count,omitempty"`
+  ExtendedInfo  *AnalyticsProductInfo  `json:"extended_info,omitempty"`
+}
+
+// AnalyticsProductInfo is returned when ExtendedInfo is requested
+type AnalyticsProductInfo struct {
+  Name           string                         `json:"name,omitempty"`
+  Image          string                         `json:"image,omitempty"`
+  Permalink      string                         `json:"permalink,omitempty"`
+  StockStatus    string                         `json:"stock_status,omitempty"`
+  StockQuantity  int                            `json:"stock_quantity,omitempty"`
+  Sku            string                         `json:"sku,omitempty"`
+  CategoryIds    []int                          `json:"category_ids,omitempty"`
+  Attributes     []AnalyticsVariationAttribute  `json:"attributes,omitempty"`
+}
+
+type AnalyticsVariationAttribute struct {
+  Id      int     `json:"id,omitempty"`
+  Name    string  `json:"name,omitempty"`
+  Option  string  `json:"option,omitempty"`
+}
+
+// AnalyticsCategory object, listed by the categories report
+type AnalyticsCategory struct {
+  CategoryId     int                     `json:"category_id,omitempty"`
+  ItemsSold      int                     `json:"items_sold,omitempty"`
+  NetRevenue     float64                 `json:"net_revenue,omitempty"`
+  OrdersCount    int                     `json:"orders_count,omitempty"`
+  ProductsCount  int                     `json:"products_count,omitempty"`
+  ExtendedInfo   *AnalyticsCategoryInfo  `json:"extended_info,omitempty"`
+}
+
+type AnalyticsCategoryInfo struct {
+  Name  string  `json:"name,omitempty"`
+}
+
+// AnalyticsCoupon object, listed by the coupons report
+type AnalyticsCoupon struct {
+  CouponId      int                   `json:"coupon_id,omitempty"`
+  Amount        float64               `json:"amount,omitempty"`
+  OrdersCount   int                   `json:"orders_count,omitempty"`
+  ExtendedInfo  *AnalyticsCouponInfo  `json:"extended_info,omitempty"`
+}
+
+type AnalyticsCouponInfo struct {
+  Code            string  `json:"code,omitempty"`
+  DateCreated     string  `json:"date_created,omitempty"`
+  DateCreatedGmt  string  `json:"date_created_gmt,omitempty"`
+  DateExpires     string  `json:"date_expires,omitempty"`
+  DateExpiresGmt  string  `json:"date_expires_gmt,omitempty"`
+  DiscountType    string  `json:"discount_type,omitempty"`
+}
+
+// AnalyticsTax object, listed by the taxes report
+type AnalyticsTax struct {
+  TaxRateId    int      `json:"tax_rate_id,omitempty"`
+  Name         string   `json:"name,omitempty"`
+  TaxRate      float64  `json:"tax_rate,omitempty"`
+  Country      string   `json:"country,omitempty"`
+  State        string   `json:"state,omitempty"`
+  Priority     int      `json:"priority,omitempty"`
+  TotalTax     float64  `json:"total_tax,omitempty"`
+  OrderTax     float64  `json:"order_tax,omitempty"`
+  ShippingTax  float64  `json:"shipping_tax,omitempty"`
+  OrdersCount  int      `json:"orders_count,omitempty"`
+}
+
+// AnalyticsStock object, listed by the stock report
+type AnalyticsStock struct {
+  Id             int      `json:"id,omitempty"`
+  ParentId       int      `json:"parent_id,omitempty"`
+  Name           string   `json:"name,omitempty"`
+  Sku            string   `json:"sku,omitempty"`
+  StockStatus    string   `json:"stock_status,omitempty"`
+  StockQuantity  float64  `json:"stock_quantity,omitempty"`
+  ManageStock    bool     `json:"manage_stock,omitempty"`
+  Links          *Links   `json:"_links,omitempty"`
+}
+
+// AnalyticsCustomer object, listed by the customers report. UserId is zero for guest customers.
+type AnalyticsCustomer struct {
+  Id                 int      `json:"id,omitempty"`
+  UserId             int      `json:"user_id,omitempty"`
+  Username           string   `json:"username,omitempty"`
+  Name               string   `json:"name,omitempty"`
+  Email              string   `json:"email,omitempty"`
+  Country            string   `json:"country,omitempty"`
+  City               string   `json:"city,omitempty"`
+  State              string   `json:"state,omitempty"`
+  Postcode           string   `json:"postcode,omitempty"`
+  DateRegistered     string   `json:"date_registered,omitempty"`
+  DateRegisteredGmt  string   `json:"date_registered_gmt,omitempty"`
+  DateLastActive     string   `json:"date_last_active,omitempty"`
+  DateLastActiveGmt  string   `json:"date_last_active_gmt,omitempty"`
+  DateLastOrder      string   `json:"date_last_order,omitempty"`
+  OrdersCount        int      `json:"orders_count,omitempty"`
+  TotalSpend         float64  `json:"total_spend,omitempty"`
+  AvgOrderValue      float64  `json:"avg_order_value,omitempty"`
+  Links              *Links   `json:"_links,omitempty"`
+}
+
+// AnalyticsStatsParams filter the revenue and orders stats reports. Dates are ISO 8601 (eg. "2024-01-01T00:00:00"),
+// and pages hold intervals.
+type AnalyticsStatsParams struct {
+  Context    string    `url:"context,omitempty"`
+  Page       int       `url:"page,omitempty"`
+  PerPage    int       `url:"per_page,omitempty"`
+  After      string    `url:"after,omitempty"`
+  Before     string    `url:"before,omitempty"`
+  Order      string    `url:"order,omitempty"`
+  OrderBy    string    `url:"orderby,omitempty"`
+  Interval   string    `url:"interval,omitempty"`
+  SegmentBy  string    `url:"segmentby,omitempty"`
+  Fields     []string  `url:"fields,omitempty,comma"`
+}
+
+// AnalyticsReportParams filter the products, variations, categories, coupons, taxes, stock and customers reports
+type AnalyticsReportParams struct {
+  Context       string    `url:"context,omitempty"`
+  Page          int       `url:"page,omitempty"`
+  PerPage       int       `url:"per_page,omitempty"`
+  After         string    `url:"after,omitempty"`
+  Before        string    `url:"before,omitempty"`
+  Order         string    `url:"order,omitempty"`
+  OrderBy       string    `url:"orderby,omitempty"`
+  Match         string    `url:"match,omitempty"`
+  ExtendedInfo  bool      `url:"extended_info,omitempty"`
+  Search        string    `url:"search,omitempty"`
+  Type          string    `url:"type,omitempty"`
+  Products      []int     `url:"products,omitempty,comma"`
+  Variations    []int     `url:"variations,omitempty,comma"`
+  Categories    []int     `url:"categories,omitempty,comma"`
+  Coupons       []int     `url:"coupons,omitempty,comma"`
+  Taxes         []int     `url:"taxes,omitempty,comma"`
+}
+
+// Get the revenue stats report. Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Revenue/Stats
+func (service *AnalyticsService) RevenueStats(opts *AnalyticsStatsParams) (*AnalyticsStats, *Response, error) {
+  return service.RevenueStatsWithContext(context.Background(), opts)
+}
+
+// Get the revenue stats report with context. Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Revenue/Stats
+func (service *AnalyticsService) RevenueStatsWithContext(ctx context.Context, opts *AnalyticsStatsParams) (*AnalyticsStats, *Response, error) {
+  _url := "/reports/revenue/stats"
+  req, _ := service.client.NewRequestWithContext(analyticsContext(ctx), "GET", _url, opts, nil)
+
+  stats := new(AnalyticsStats)
+  response, err := service.client.Do(req, stats)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return stats, response, nil
+}
+
+// PaginateRevenueStats paginates the intervals of the revenue stats report, fetching each page as it is iterated.
+// Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Revenue/Stats
+func (service *AnalyticsService) PaginateRevenueStats(opts *AnalyticsStatsParams) *Paginator[AnalyticsInterval] {
+  params := AnalyticsStatsParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]AnalyticsInterval, *Response, error) {
+    params.Page = page
+    stats, response, err := service.RevenueStatsWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return stats.Intervals, response, nil
+  })
+}
+
+// Get the orders stats report. Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Orders/Stats
+func (service *AnalyticsService) OrdersStats(opts *AnalyticsStatsParams) (*AnalyticsStats, *Response, error) {
+  return service.OrdersStatsWithContext(context.Background(), opts)
+}
+
+// Get the orders stats report with context. Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Orders/Stats
+func (service *AnalyticsService) OrdersStatsWithContext(ctx context.Context, opts *AnalyticsStatsParams) (*AnalyticsStats, *Response, error) {
+  _url := "/reports/orders/stats"
+  req, _ := service.client.NewRequestWithContext(analyticsContext(ctx), "GET", _url, opts, nil)
+
+  stats := new(AnalyticsStats)
+  response, err := service.client.Do(req, stats)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return stats, response, nil
+}
+
+// PaginateOrdersStats paginates the intervals of the orders stats report, fetching each page as it is iterated.
+// Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Orders/Stats
+func (service *AnalyticsService) PaginateOrdersStats(opts *AnalyticsStatsParams) *Paginator[AnalyticsInterval] {
+  params := AnalyticsStatsParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]AnalyticsInterval, *Response, error) {
+    params.Page = page
+    stats, response, err := service.OrdersStatsWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return stats.Intervals, response, nil
+  })
+}
+
+// List the products report. Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Products
+func (service *AnalyticsService) Products(opts *AnalyticsReportParams) (*[]AnalyticsProduct, *Response, error) {
+  return service.ProductsWithContext(context.Background(), opts)
+}
+
+// List the products report with context. Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Products
+func (service *AnalyticsService) ProductsWithContext(ctx context.Context, opts *AnalyticsReportParams) (*[]AnalyticsProduct, *Response, error) {
+  _url := "/reports/products"
+  req, _ := service.client.NewRequestWithContext(analyticsContext(ctx), "GET", _url, opts, nil)
+
+  products := new([]AnalyticsProduct)
+  response, err := service.client.Do(req, products)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return products, response, nil
+}
+
+// PaginateProducts paginates the products report, fetching each page as it is iterated.
+// Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Products
+func (service *AnalyticsService) PaginateProducts(opts *AnalyticsReportParams) *Paginator[AnalyticsProduct] {
+  params := AnalyticsReportParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]AnalyticsProduct, *Response, error) {
+    params.Page = page
+    products, response, err := service.ProductsWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *products, response, nil
+  })
+}
+
+// List the variations report. Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Variations
+func (service *AnalyticsService) Variations(opts *AnalyticsReportParams) (*[]AnalyticsProduct, *Response, error) {
+  return service.VariationsWithContext(context.Background(), opts)
+}
+
+// List the variations report with context. Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Variations
+func (service *AnalyticsService) VariationsWithContext(ctx context.Context, opts *AnalyticsReportParams) (*[]AnalyticsProduct, *Response, error) {
+  _url := "/reports/variations"
+  req, _ := service.client.NewRequestWithContext(analyticsContext(ctx), "GET", _url, opts, nil)
+
+  variations := new([]AnalyticsProduct)
+  response, err := service.client.Do(req, variations)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return variations, response, nil
+}
+
+// PaginateVariations paginates the variations report, fetching each page as it is iterated.
+// Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Variations
+func (service *AnalyticsService) PaginateVariations(opts *AnalyticsReportParams) *Paginator[AnalyticsProduct] {
+  params := AnalyticsReportParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]AnalyticsProduct, *Response, error) {
+    params.Page = page
+    variations, response, err := service.VariationsWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *variations, response, nil
+  })
+}
+
+// List the categories report. Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Categories
+func (service *AnalyticsService) Categories(opts *AnalyticsReportParams) (*[]AnalyticsCategory, *Response, error) {
+  return service.CategoriesWithContext(context.Background(), opts)
+}
+
+// List the categories report with context. Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Categories
+func (service *AnalyticsService) CategoriesWithContext(ctx context.Context, opts *AnalyticsReportParams) (*[]AnalyticsCategory, *Response, error) {
+  _url := "/reports/categories"
+  req, _ := service.client.NewRequestWithContext(analyticsContext(ctx), "GET", _url, opts, nil)
+
+  categories := new([]AnalyticsCategory)
+  response, err := service.client.Do(req, categories)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return categories, response, nil
+}
+
+// PaginateCategories paginates the categories report, fetching each page as it is iterated.
+// Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Categories
+func (service *AnalyticsService) PaginateCategories(opts *AnalyticsReportParams) *Paginator[AnalyticsCategory] {
+  params := AnalyticsReportParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]AnalyticsCategory, *Response, error) {
+    params.Page = page
+    categories, response, err := service.CategoriesWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *categories, response, nil
+  })
+}
+
+// List the coupons report. Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Coupons
+func (service *AnalyticsService) Coupons(opts *AnalyticsReportParams) (*[]AnalyticsCoupon, *Response, error) {
+  return service.CouponsWithContext(context.Background(), opts)
+}
+
+// List the coupons report with context. Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Coupons
+func (service *AnalyticsService) CouponsWithContext(ctx context.Context, opts *AnalyticsReportParams) (*[]AnalyticsCoupon, *Response, error) {
+  _url := "/reports/coupons"
+  req, _ := service.client.NewRequestWithContext(analyticsContext(ctx), "GET", _url, opts, nil)
+
+  coupons := new([]AnalyticsCoupon)
+  response, err := service.client.Do(req, coupons)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return coupons, response, nil
+}
+
+// PaginateCoupons paginates the coupons report, fetching each page as it is iterated.
+// Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Coupons
+func (service *AnalyticsService) PaginateCoupons(opts *AnalyticsReportParams) *Paginator[AnalyticsCoupon] {
+  params := AnalyticsReportParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]AnalyticsCoupon, *Response, error) {
+    params.Page = page
+    coupons, response, err := service.CouponsWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *coupons, response, nil
+  })
+}
+
+// List the taxes report. Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Taxes
+func (service *AnalyticsService) Taxes(opts *AnalyticsReportParams) (*[]AnalyticsTax, *Response, error) {
+  return service.TaxesWithContext(context.Background(), opts)
+}
+
+// List the taxes report with context. Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Taxes
+func (service *AnalyticsService) TaxesWithContext(ctx context.Context, opts *AnalyticsReportParams) (*[]AnalyticsTax, *Response, error) {
+  _url := "/reports/taxes"
+  req, _ := service.client.NewRequestWithContext(analyticsContext(ctx), "GET", _url, opts, nil)
+
+  taxes := new([]AnalyticsTax)
+  response, err := service.client.Do(req, taxes)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return taxes, response, nil
+}
+
+// PaginateTaxes paginates the taxes report, fetching each page as it is iterated.
+// Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Taxes
+func (service *AnalyticsService) PaginateTaxes(opts *AnalyticsReportParams) *Paginator[AnalyticsTax] {
+  params := AnalyticsReportParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]AnalyticsTax, *Response, error) {
+    params.Page = page
+    taxes, response, err := service.TaxesWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *taxes, response, nil
+  })
+}
+
+// List the stock report. Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Stock
+func (service *AnalyticsService) Stock(opts *AnalyticsReportParams) (*[]AnalyticsStock, *Response, error) {
+  return service.StockWithContext(context.Background(), opts)
+}
+
+// List the stock report with context. Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Stock
+func (service *AnalyticsService) StockWithContext(ctx context.Context, opts *AnalyticsReportParams) (*[]AnalyticsStock, *Response, error) {
+  _url := "/reports/stock"
+  req, _ := service.client.NewRequestWithContext(analyticsContext(ctx), "GET", _url, opts, nil)
+
+  stock := new([]AnalyticsStock)
+  response, err := service.client.Do(req, stock)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return stock, response, nil
+}
+
+// PaginateStock paginates the stock report, fetching each page as it is iterated.
+// Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Stock
+func (service *AnalyticsService) PaginateStock(opts *AnalyticsReportParams) *Paginator[AnalyticsStock] {
+  params := AnalyticsReportParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]AnalyticsStock, *Response, error) {
+    params.Page = page
+    stock, response, err := service.StockWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *stock, response, nil
+  })
+}
+
+// List the customers report. Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Customers
+func (service *AnalyticsService) Customers(opts *AnalyticsReportParams) (*[]AnalyticsCustomer, *Response, error) {
+  return service.CustomersWithContext(context.Background(), opts)
+}
+
+// List the customers report with context. Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Customers
+func (service *AnalyticsService) CustomersWithContext(ctx context.Context, opts *AnalyticsReportParams) (*[]AnalyticsCustomer, *Response, error) {
+  _url := "/reports/customers"
+  req, _ := service.client.NewRequestWithContext(analyticsContext(ctx), "GET", _url, opts, nil)
+
+  customers := new([]AnalyticsCustomer)
+  response, err := service.client.Do(req, customers)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return customers, response, nil
+}
+
+// PaginateCustomers paginates the customers report, fetching each page as it is iterated.
+// Reference: https://github.com/woocommerce/woocommerce/tree/trunk/plugins/woocommerce/src/Admin/API/Reports/Customers
+func (service *AnalyticsService) PaginateCustomers(opts *AnalyticsReportParams) *Paginator[AnalyticsCustomer] {
+  params := AnalyticsReportParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]AnalyticsCustomer, *Response, error) {
+    params.Page = page
+    customers, response, err := service.CustomersWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *customers, response, nil
+  })
+}
+
+// analyticsContext sends requests created with the context to the wc-analytics namespace
+func analyticsContext(ctx context.Context) context.Context {
+  return ContextWithNamespace(ctx, NamespaceAnalytics)
+}
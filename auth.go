@@ -0,0 +1,128 @@
+package woocommerce
+
+import (
+  "context"
+  "errors"
+  "net/http"
+  "sync"
+)
+
+var errorAuthMissingToken = errors.New("bearer token is empty and no refresh callback is set")
+
+// Authenticator adds credentials to API requests. It is applied when a request
+// is sent and again before each retry, so implementations must be idempotent.
+type Authenticator interface {
+  Authenticate(req *http.Request) error
+}
+
+// credentialsRefresher is implemented by authenticators that can refresh their
+// credentials, after the store rejects a request as unauthorized (HTTP 401)
+type credentialsRefresher interface {
+  RefreshCredentials(ctx context.Context) error
+}
+
+// BasicAuth authenticates with REST API keys, using the Authorization header (HTTPS only)
+type BasicAuth struct {
+  ConsumerKey    string
+  ConsumerSecret string
+}
+
+func (auth *BasicAuth) Authenticate(req *http.Request) error {
+  req.SetBasicAuth(auth.ConsumerKey, auth.ConsumerSecret)
+
+  return nil
+}
+
+// QueryStringAuth authenticates with REST API keys sent as query string parameters,
+// for hosts that strip the Authorization header (HTTPS only).
+// Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#authentication-over-https
+type QueryStringAuth struct {
+  ConsumerKey    string
+  ConsumerSecret string
+}
+
+func (auth *QueryStringAuth) Authenticate(req *http.Request) error {
+  query := req.URL.Query()
+
+  query.Set("consumer_key", auth.ConsumerKey)
+  query.Set("consumer_secret", auth.ConsumerSecret)
+
+  req.URL.RawQuery = query.Encode()
+
+  return nil
+}
+
+// OAuth1Auth authenticates with REST API keys, signing requests with one-legged
+// OAuth 1.0a (required when the store is not served over HTTPS)
+type OAuth1Auth struct {
+  ConsumerKey     string
+  ConsumerSecret  string
+  SignatureMethod string
+}
+
+func (auth *OAuth1Auth) Authenticate(req *http.Request) error {
+  return newOAuthSigner(auth.ConsumerKey, auth.ConsumerSecret, auth.SignatureMethod).sign(req)
+}
+
+// ApplicationPasswordAuth authenticates with a WordPress user and one of its Application Passwords.
+// Reference: https://make.wordpress.org/core/2020/11/05/application-passwords-integration-guide/
+type ApplicationPasswordAuth struct {
+  Username string
+  Password string
+}
+
+func (auth *ApplicationPasswordAuth) Authenticate(req *http.Request) error {
+  req.SetBasicAuth(auth.Username, auth.Password)
+
+  return nil
+}
+
+// BearerTokenAuth authenticates with a bearer token (eg. a JWT). When Refresh is set, it is
+// called to fetch the first token, and again when the store rejects the current token.
+type BearerTokenAuth struct {
+  Token   string
+  Refresh func(ctx context.Context) (string, error)
+
+  mutex sync.Mutex
+}
+
+func (auth *BearerTokenAuth) Authenticate(req *http.Request) error {
+  auth.mutex.Lock()
+  defer auth.mutex.Unlock()
+
+  // Fetch first token?
+  if auth.Token == "" {
+    if auth.Refresh == nil {
+      return errorAuthMissingToken
+    }
+
+    token, err := auth.Refresh(req.Context())
+    if err != nil {
+      return err
+    }
+
+    auth.Token = token
+  }
+
+  req.Header.Set("Authorization", "Bearer "+auth.Token)
+
+  return nil
+}
+
+// RefreshCredentials replaces the current token using the Refresh callback
+func (auth *BearerTokenAuth) RefreshCredentials(ctx context.Context) error {
+  if auth.Refresh == nil {
+    return errorAuthMissingToken
+  }
+
+  token, err := auth.Refresh(ctx)
+  if err != nil {
+    return err
+  }
+
+  auth.mutex.Lock()
+  auth.Token = token
+  auth.mutex.Unlock()
+
+  return nil
+}
@@ -0,0 +1,101 @@
+package woocommerce
+
+import (
+  "context"
+  "errors"
+  "fmt"
+  "net/http"
+  "net/http/httptest"
+  "sync/atomic"
+  "testing"
+)
+
+func TestBearerTokenAuthErrorsReachCaller(t *testing.T) {
+  errorRefresh := errors.New("token endpoint unavailable")
+
+  tests := []struct {
+    name         string
+    auth         *BearerTokenAuth
+    ctx          func() context.Context
+    wantErr      error
+    wantRequests int32
+    unauthorized bool
+  }{
+    {
+      name: "first token refresh fails",
+      auth: &BearerTokenAuth{Refresh: func(ctx context.Context) (string, error) {
+        return "", errorRefresh
+      }},
+      wantErr: errorRefresh,
+    },
+    {
+      name:    "no token and no refresh",
+      auth:    &BearerTokenAuth{},
+      wantErr: errorAuthMissingToken,
+    },
+    {
+      name: "refresh after rejected token fails",
+      auth: &BearerTokenAuth{Token: "expired", Refresh: func(ctx context.Context) (string, error) {
+        return "", errorRefresh
+      }},
+      wantErr:      errorRefresh,
+      wantRequests: 1,
+      unauthorized: true,
+    },
+    {
+      name: "cancelled context",
+      auth: &BearerTokenAuth{Refresh: func(ctx context.Context) (string, error) {
+        return "", ctx.Err()
+      }},
+      ctx: func() context.Context {
+        ctx, cancel := context.WithCancel(context.Background())
+        cancel()
+
+        return ctx
+      },
+      wantErr: context.Canceled,
+    },
+  }
+
+  for _, test := range tests {
+    t.Run(test.name, func(t *testing.T) {
+      var requests atomic.Int32
+
+      server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        requests.Add(1)
+
+        w.WriteHeader(http.StatusUnauthorized)
+        fmt.Fprint(w, `{"code":"jwt_auth_invalid_token","message":"Expired token","data":{"status":401}}`)
+      }))
+      defer server.Close()
+
+      client, err := New(server.URL, WithAuthenticator(test.auth))
+      if err != nil {
+        t.Fatal(err)
+      }
+
+      ctx := context.Background()
+      if test.ctx != nil {
+        ctx = test.ctx()
+      }
+
+      _, _, err = client.Products.GetWithContext(ctx, "42")
+
+      if !errors.Is(err, test.wantErr) {
+        t.Fatalf("Get() error = %v, want %v", err, test.wantErr)
+      }
+
+      if errors.Is(err, errorDoAttemptNilRequest) {
+        t.Errorf("Get() error = %v, want the authenticator error only", err)
+      }
+
+      if IsUnauthorized(err) != test.unauthorized {
+        t.Errorf("IsUnauthorized() = %v, want %v", IsUnauthorized(err), test.unauthorized)
+      }
+
+      if got := requests.Load(); got != test.wantRequests {
+        t.Errorf("requests = %d, want %d", got, test.wantRequests)
+      }
+    })
+  }
+}
@@ -1,7 +1,7 @@
 package woocommerce
 
 import (
-  "net/http"
+  "context"
 )
 
 // Coupon service
@@ -75,9 +75,14 @@ type BatchCouponUpdateResponse struct {
 }
 
 // Create a coupon. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-coupon
-func (service *CouponsService) Create(coupon *Coupon) (*Coupon, *http.Response, error) {
+func (service *CouponsService) Create(coupon *Coupon) (*Coupon, *Response, error) {
+  return service.CreateWithContext(context.Background(), coupon)
+}
+
+// Create a coupon with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-coupon
+func (service *CouponsService) CreateWithContext(ctx context.Context, coupon *Coupon) (*Coupon, *Response, error) {
   _url := "/coupons" 
-  req, _ := service.client.NewRequest("POST", _url, nil, coupon)
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, coupon)
 
   createdCoupon := new(Coupon)
   response, err := service.client.Do(req, createdCoupon)
@@ -90,9 +95,14 @@ func (service *CouponsService) Create(coupon *Coupon) (*Coupon, *http.Response,
 }
 
 // Get a coupon. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-coupon
-func (service *CouponsService) Get(couponID string) (*Coupon, *http.Response, error) {
+func (service *CouponsService) Get(couponID string) (*Coupon, *Response, error) {
+  return service.GetWithContext(context.Background(), couponID)
+}
+
+// Get a coupon with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-coupon
+func (service *CouponsService) GetWithContext(ctx context.Context, couponID string) (*Coupon, *Response, error) {
   _url := "/coupons/" + couponID
-  req, _ := service.client.NewRequest("GET", _url, nil, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
 
   coupon := new(Coupon)
   response, err := service.client.Do(req, coupon)
@@ -105,9 +115,14 @@ func (service *CouponsService) Get(couponID string) (*Coupon, *http.Response, er
 }
 
 // List coupons. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-coupons
-func (service *CouponsService) List(opts *ListCouponParams) (*[]Coupon, *http.Response, error) {
+func (service *CouponsService) List(opts *ListCouponParams) (*[]Coupon, *Response, error) {
+  return service.ListWithContext(context.Background(), opts)
+}
+
+// List coupons with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-coupons
+func (service *CouponsService) ListWithContext(ctx context.Context, opts *ListCouponParams) (*[]Coupon, *Response, error) {
   _url := "/coupons"
-  req, _ := service.client.NewRequest("GET", _url, opts, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
 
   coupons := new([]Coupon)
   response, err := service.client.Do(req, coupons)
@@ -120,9 +135,14 @@ func (service *CouponsService) List(opts *ListCouponParams) (*[]Coupon, *http.Re
 }
 
 // Update a coupon. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-coupon
-func (service *CouponsService) Update(couponID string, coupon *Coupon) (*Coupon, *http.Response, error) {
+func (service *CouponsService) Update(couponID string, coupon *Coupon) (*Coupon, *Response, error) {
+  return service.UpdateWithContext(context.Background(), couponID, coupon)
+}
+
+// Update a coupon with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-coupon
+func (service *CouponsService) UpdateWithContext(ctx context.Context, couponID string, coupon *Coupon) (*Coupon, *Response, error) {
   _url := "/coupons/" + couponID
-  req, _ := service.client.NewRequest("PUT", _url, nil, coupon)
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, coupon)
 
   updatedCoupon := new(Coupon)
   response, err := service.client.Do(req, updatedCoupon)
@@ -135,9 +155,14 @@ func (service *CouponsService) Update(couponID string, coupon *Coupon) (*Coupon,
 }
 
 // Delete a coupon. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-coupon
-func (service *CouponsService) Delete(couponID string, opts *DeleteCouponParams) (*Coupon, *http.Response, error) {
+func (service *CouponsService) Delete(couponID string, opts *DeleteCouponParams) (*Coupon, *Response, error) {
+  return service.DeleteWithContext(context.Background(), couponID, opts)
+}
+
+// Delete a coupon with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-coupon
+func (service *CouponsService) DeleteWithContext(ctx context.Context, couponID string, opts *DeleteCouponParams) (*Coupon, *Response, error) {
   _url := "/coupons/" + couponID
-  req, _ := service.client.NewRequest("DELETE", _url, opts, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
 
   coupon := new(Coupon)
   response, err := service.client.Do(req, coupon)
@@ -150,9 +175,14 @@ func (service *CouponsService) Delete(couponID string, opts *DeleteCouponParams)
 }
 
 // Batch update coupons. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-coupons
-func (service *CouponsService) Batch(opts *BatchCouponUpdate) (*BatchCouponUpdateResponse, *http.Response, error) {
+func (service *CouponsService) Batch(opts *BatchCouponUpdate) (*BatchCouponUpdateResponse, *Response, error) {
+  return service.BatchWithContext(context.Background(), opts)
+}
+
+// Batch update coupons with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-coupons
+func (service *CouponsService) BatchWithContext(ctx context.Context, opts *BatchCouponUpdate) (*BatchCouponUpdateResponse, *Response, error) {
   _url := "/coupons/batch"
-  req, _ := service.client.NewRequest("POST", _url, nil, opts)
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, opts)
 
   coupons := new(BatchCouponUpdateResponse)
   response, err := service.client.Do(req, coupons)
@@ -162,4 +192,29 @@ func (service *CouponsService) Batch(opts *BatchCouponUpdate) (*BatchCouponUpdat
   }
 
   return coupons, response, nil
+}
+
+// Paginate coupons, fetching each page as it is iterated
+func (service *CouponsService) Paginate(opts *ListCouponParams) *Paginator[Coupon] {
+  params := ListCouponParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]Coupon, *Response, error) {
+    params.Page = page
+    coupons, response, err := service.ListWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *coupons, response, nil
+  })
+}
+
+// List all coupons across every page
+func (service *CouponsService) ListAll(ctx context.Context, opts *ListCouponParams) ([]Coupon, error) {
+  return service.Paginate(opts).Collect(ctx)
 }
\ No newline at end of file
@@ -1,7 +1,7 @@
 package woocommerce
 
 import (
-  "net/http"
+  "context"
 )
 
 // Customer service
@@ -25,6 +25,17 @@ type Customer struct {
   Billing          *Billing      `json:"billing,omitempty"`
   Shipping         *Shipping     `json:"shipping,omitempty"`
   Links            *Links        `json:"_links,omitempty"`
+
+  // Legacy fields, only returned by the v1 and v2 API
+  OrdersCount      int                `json:"orders_count,omitempty"`
+  TotalSpent       string             `json:"total_spent,omitempty"`
+  LastOrder        *CustomerLastOrder `json:"last_order,omitempty"`
+}
+
+// CustomerLastOrder object, only returned by the v1 API
+type CustomerLastOrder struct {
+  ID    int    `json:"id,omitempty"`
+  Date  string `json:"date,omitempty"`
 }
 
 type ListCustomerParams struct {
@@ -80,9 +91,14 @@ type File struct  {
 }
 
 // Create a customer. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-customer
-func (service *CustomersService) Create(customer *Customer) (*Customer, *http.Response, error) {
+func (service *CustomersService) Create(customer *Customer) (*Customer, *Response, error) {
+  return service.CreateWithContext(context.Background(), customer)
+}
+
+// Create a customer with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-customer
+func (service *CustomersService) CreateWithContext(ctx context.Context, customer *Customer) (*Customer, *Response, error) {
   _url := "/customers" 
-  req, _ := service.client.NewRequest("POST", _url, nil, customer)
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, customer)
 
   createdCustomer := new(Customer)
   response, err := service.client.Do(req, createdCustomer)
@@ -95,9 +111,14 @@ func (service *CustomersService) Create(customer *Customer) (*Customer, *http.Re
 }
 
 // Get a customer. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-customer
-func (service *CustomersService) Get(customerID string) (*Customer, *http.Response, error) {
+func (service *CustomersService) Get(customerID string) (*Customer, *Response, error) {
+  return service.GetWithContext(context.Background(), customerID)
+}
+
+// Get a customer with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-customer
+func (service *CustomersService) GetWithContext(ctx context.Context, customerID string) (*Customer, *Response, error) {
   _url := "/customers/" + customerID
-  req, _ := service.client.NewRequest("GET", _url, nil, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
 
   customer := new(Customer)
   response, err := service.client.Do(req, customer)
@@ -110,9 +131,14 @@ func (service *CustomersService) Get(customerID string) (*Customer, *http.Respon
 }
 
 // List customers. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-customers
-func (service *CustomersService) List(opts *ListCustomerParams) (*[]Customer, *http.Response, error) {
+func (service *CustomersService) List(opts *ListCustomerParams) (*[]Customer, *Response, error) {
+  return service.ListWithContext(context.Background(), opts)
+}
+
+// List customers with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-customers
+func (service *CustomersService) ListWithContext(ctx context.Context, opts *ListCustomerParams) (*[]Customer, *Response, error) {
   _url := "/customers"
-  req, _ := service.client.NewRequest("GET", _url, opts, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
 
   customers := new([]Customer)
   response, err := service.client.Do(req, customers)
@@ -125,9 +151,14 @@ func (service *CustomersService) List(opts *ListCustomerParams) (*[]Customer, *h
 }
 
 // Update a customer. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-customer
-func (service *CustomersService) Update(customerID string, customer *Customer) (*Customer, *http.Response, error) {
+func (service *CustomersService) Update(customerID string, customer *Customer) (*Customer, *Response, error) {
+  return service.UpdateWithContext(context.Background(), customerID, customer)
+}
+
+// Update a customer with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-customer
+func (service *CustomersService) UpdateWithContext(ctx context.Context, customerID string, customer *Customer) (*Customer, *Response, error) {
   _url := "/customers/" + customerID
-  req, _ := service.client.NewRequest("PUT", _url, nil, customer)
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, customer)
 
   updatedCustomer := new(Customer)
   response, err := service.client.Do(req, updatedCustomer)
@@ -140,9 +171,14 @@ func (service *CustomersService) Update(customerID string, customer *Customer) (
 }
 
 // Delete a customer. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-customer
-func (service *CustomersService) Delete(customerID string, opts *DeleteCustomerParams) (*Customer, *http.Response, error) {
+func (service *CustomersService) Delete(customerID string, opts *DeleteCustomerParams) (*Customer, *Response, error) {
+  return service.DeleteWithContext(context.Background(), customerID, opts)
+}
+
+// Delete a customer with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-customer
+func (service *CustomersService) DeleteWithContext(ctx context.Context, customerID string, opts *DeleteCustomerParams) (*Customer, *Response, error) {
   _url := "/customers/" + customerID
-  req, _ := service.client.NewRequest("DELETE", _url, opts, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
 
   customer := new(Customer)
   response, err := service.client.Do(req, customer)
@@ -155,9 +191,14 @@ func (service *CustomersService) Delete(customerID string, opts *DeleteCustomerP
 }
 
 // Batch update customers. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-customers
-func (service *CustomersService) Batch(opts *BatchCustomerUpdate) (*BatchCustomerUpdateResponse, *http.Response, error) {
+func (service *CustomersService) Batch(opts *BatchCustomerUpdate) (*BatchCustomerUpdateResponse, *Response, error) {
+  return service.BatchWithContext(context.Background(), opts)
+}
+
+// Batch update customers with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-customers
+func (service *CustomersService) BatchWithContext(ctx context.Context, opts *BatchCustomerUpdate) (*BatchCustomerUpdateResponse, *Response, error) {
   _url := "/customers/batch"
-  req, _ := service.client.NewRequest("POST", _url, nil, opts)
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, opts)
 
   customers := new(BatchCustomerUpdateResponse)
   response, err := service.client.Do(req, customers)
@@ -170,9 +211,14 @@ func (service *CustomersService) Batch(opts *BatchCustomerUpdate) (*BatchCustome
 }
 
 // Get customer downloads. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-customer-downloads
-func (service *CustomersService) GetDownloads(customerID string) (*[]CustomerDownload, *http.Response, error) {
+func (service *CustomersService) GetDownloads(customerID string) (*[]CustomerDownload, *Response, error) {
+  return service.GetDownloadsWithContext(context.Background(), customerID)
+}
+
+// Get customer downloads with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-customer-downloads
+func (service *CustomersService) GetDownloadsWithContext(ctx context.Context, customerID string) (*[]CustomerDownload, *Response, error) {
   _url := "/customers/" + customerID + "/downloads"
-  req, _ := service.client.NewRequest("GET", _url, nil, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
 
   downloads := new([]CustomerDownload)
   response, err := service.client.Do(req, downloads)
@@ -182,4 +228,29 @@ func (service *CustomersService) GetDownloads(customerID string) (*[]CustomerDow
   }
 
   return downloads, response, nil
+}
+
+// Paginate customers, fetching each page as it is iterated
+func (service *CustomersService) Paginate(opts *ListCustomerParams) *Paginator[Customer] {
+  params := ListCustomerParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]Customer, *Response, error) {
+    params.Page = page
+    customers, response, err := service.ListWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *customers, response, nil
+  })
+}
+
+// List all customers across every page
+func (service *CustomersService) ListAll(ctx context.Context, opts *ListCustomerParams) ([]Customer, error) {
+  return service.Paginate(opts).Collect(ctx)
 }
\ No newline at end of file
@@ -0,0 +1,310 @@
+package woocommerce
+
+import (
+  "bytes"
+  "context"
+  "encoding/json"
+  "sync"
+  "sync/atomic"
+  "time"
+)
+
+// Data service. Responses can be cached in memory with EnableCache, as they rarely change.
+type DataService struct {
+  client *Client
+
+  cache atomic.Pointer[dataCache]
+}
+
+// DataResource object, listed by the data index. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-data
+type DataResource struct {
+  Slug         string      `json:"slug,omitempty"`
+  Description  string      `json:"description,omitempty"`
+  Links        *Links      `json:"_links,omitempty"`
+}
+
+// Continent object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#continents-properties
+type Continent struct {
+  Code       string               `json:"code,omitempty"`
+  Name       string               `json:"name,omitempty"`
+  Countries  []ContinentCountry   `json:"countries,omitempty"`
+  Links      *Links               `json:"_links,omitempty"`
+}
+
+// ContinentCountry is a country of a continent, with its locale
+type ContinentCountry struct {
+  Code           string          `json:"code,omitempty"`
+  Name           string          `json:"name,omitempty"`
+  CurrencyCode   string          `json:"currency_code,omitempty"`
+  CurrencyPos    string          `json:"currency_pos,omitempty"`
+  DecimalSep     string          `json:"decimal_sep,omitempty"`
+  DimensionUnit  string          `json:"dimension_unit,omitempty"`
+  NumDecimals    int             `json:"num_decimals,omitempty"`
+  ThousandSep    string          `json:"thousand_sep,omitempty"`
+  WeightUnit     string          `json:"weight_unit,omitempty"`
+  States         []CountryState  `json:"states,omitempty"`
+}
+
+// Country object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#countries-properties
+type Country struct {
+  Code    string          `json:"code,omitempty"`
+  Name    string          `json:"name,omitempty"`
+  States  []CountryState  `json:"states,omitempty"`
+  Links   *Links          `json:"_links,omitempty"`
+}
+
+type CountryState struct {
+  Code  string `json:"code,omitempty"`
+  Name  string `json:"name,omitempty"`
+}
+
+// Currency object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#currencies-properties
+type Currency struct {
+  Code    string    `json:"code,omitempty"`
+  Name    string    `json:"name,omitempty"`
+  Symbol  string    `json:"symbol,omitempty"`
+  Links   *Links    `json:"_links,omitempty"`
+}
+
+// dataCache holds response bodies, keyed by request path. Bodies are decoded again on each
+// hit, so callers never share (and cannot modify) a cached value.
+type dataCache struct {
+  ttl     time.Duration
+  mutex   sync.Mutex
+  entries map[string]dataCacheEntry
+}
+
+type dataCacheEntry struct {
+  body     []byte
+  response *Response
+  expires  time.Time
+}
+
+// List all data resources. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-data
+func (service *DataService) List() (*[]DataResource, *Response, error) {
+  return service.ListWithContext(context.Background())
+}
+
+// List all data resources with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-data
+func (service *DataService) ListWithContext(ctx context.Context) (*[]DataResource, *Response, error) {
+  resources := new([]DataResource)
+  response, err := service.get(ctx, "/data", resources)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return resources, response, nil
+}
+
+// List all continents. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-continents
+func (service *DataService) ListContinents() (*[]Continent, *Response, error) {
+  return service.ListContinentsWithContext(context.Background())
+}
+
+// List all continents with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-continents
+func (service *DataService) ListContinentsWithContext(ctx context.Context) (*[]Continent, *Response, error) {
+  continents := new([]Continent)
+  response, err := service.get(ctx, "/data/continents", continents)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return continents, response, nil
+}
+
+// Get a continent. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-continent-data
+func (service *DataService) GetContinent(code string) (*Continent, *Response, error) {
+  return service.GetContinentWithContext(context.Background(), code)
+}
+
+// Get a continent with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-continent-data
+func (service *DataService) GetContinentWithContext(ctx context.Context, code string) (*Continent, *Response, error) {
+  continent := new(Continent)
+  response, err := service.get(ctx, "/data/continents/" + code, continent)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return continent, response, nil
+}
+
+// List all countries. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-countries
+func (service *DataService) ListCountries() (*[]Country, *Response, error) {
+  return service.ListCountriesWithContext(context.Background())
+}
+
+// List all countries with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-countries
+func (service *DataService) ListCountriesWithContext(ctx context.Context) (*[]Country, *Response, error) {
+  countries := new([]Country)
+  response, err := service.get(ctx, "/data/countries", countries)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return countries, response, nil
+}
+
+// Get a country. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-country-data
+func (service *DataService) GetCountry(code string) (*Country, *Response, error) {
+  return service.GetCountryWithContext(context.Background(), code)
+}
+
+// Get a country with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-country-data
+func (service *DataService) GetCountryWithContext(ctx context.Context, code string) (*Country, *Response, error) {
+  country := new(Country)
+  response, err := service.get(ctx, "/data/countries/" + code, country)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return country, response, nil
+}
+
+// List all currencies. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-currencies
+func (service *DataService) ListCurrencies() (*[]Currency, *Response, error) {
+  return service.ListCurrenciesWithContext(context.Background())
+}
+
+// List all currencies with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-currencies
+func (service *DataService) ListCurrenciesWithContext(ctx context.Context) (*[]Currency, *Response, error) {
+  currencies := new([]Currency)
+  response, err := service.get(ctx, "/data/currencies", currencies)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return currencies, response, nil
+}
+
+// Get a currency. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-currency-data
+func (service *DataService) GetCurrency(code string) (*Currency, *Response, error) {
+  return service.GetCurrencyWithContext(context.Background(), code)
+}
+
+// Get a currency with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-currency-data
+func (service *DataService) GetCurrencyWithContext(ctx context.Context, code string) (*Currency, *Response, error) {
+  currency := new(Currency)
+  response, err := service.get(ctx, "/data/currencies/" + code, currency)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return currency, response, nil
+}
+
+// Get the store currency. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-current-currency
+func (service *DataService) GetCurrentCurrency() (*Currency, *Response, error) {
+  return service.GetCurrentCurrencyWithContext(context.Background())
+}
+
+// Get the store currency with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-current-currency
+func (service *DataService) GetCurrentCurrencyWithContext(ctx context.Context) (*Currency, *Response, error) {
+  currency := new(Currency)
+  response, err := service.get(ctx, "/data/currencies/current", currency)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return currency, response, nil
+}
+
+// EnableCache keeps responses in memory for the given duration (forever when zero). Cache hits
+// return a copy of the cached value, with the response it was fetched with.
+func (service *DataService) EnableCache(ttl time.Duration) {
+  service.cache.Store(&dataCache{ttl: ttl, entries: map[string]dataCacheEntry{}})
+}
+
+// DisableCache stops caching responses, and drops the cached ones
+func (service *DataService) DisableCache() {
+  service.cache.Store(nil)
+}
+
+// ClearCache drops the cached responses
+func (service *DataService) ClearCache() {
+  if cache := service.cache.Load(); cache != nil {
+    cache.clear()
+  }
+}
+
+// get fetches the path into v, or decodes the cached body into v
+func (service *DataService) get(ctx context.Context, urlStr string, v interface{}) (*Response, error) {
+  cache := service.cache.Load()
+  key := service.client.namespace(ctx) + urlStr
+
+  if cache != nil {
+    if response, ok, err := cache.load(key, v); ok {
+      return response, err
+    }
+  }
+
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", urlStr, nil, nil)
+
+  // Keep the raw body, so the cache holds its own copy of the value
+  body := new(bytes.Buffer)
+
+  response, err := service.client.Do(req, body)
+  if err != nil {
+    return response, err
+  }
+
+  if body.Len() > 0 {
+    if err := json.Unmarshal(body.Bytes(), v); err != nil {
+      return response, err
+    }
+  }
+
+  if cache != nil {
+    cache.store(key, body.Bytes(), response)
+  }
+
+  return response, nil
+}
+
+// load decodes the cached body into v, if it has not expired, and returns a copy of its response
+func (cache *dataCache) load(key string, v interface{}) (*Response, bool, error) {
+  cache.mutex.Lock()
+  entry, ok := cache.entries[key]
+
+  if ok && !entry.expires.IsZero() && time.Now().After(entry.expires) {
+    delete(cache.entries, key)
+    ok = false
+  }
+
+  cache.mutex.Unlock()
+
+  if !ok {
+    return nil, false, nil
+  }
+
+  response := *entry.response
+
+  return &response, true, json.Unmarshal(entry.body, v)
+}
+
+func (cache *dataCache) store(key string, body []byte, response *Response) {
+  cache.mutex.Lock()
+  defer cache.mutex.Unlock()
+
+  entry := dataCacheEntry{body: body, response: response}
+
+  if cache.ttl > 0 {
+    entry.expires = time.Now().Add(cache.ttl)
+  }
+
+  cache.entries[key] = entry
+}
+
+func (cache *dataCache) clear() {
+  cache.mutex.Lock()
+  defer cache.mutex.Unlock()
+
+  cache.entries = map[string]dataCacheEntry{}
+}
\ No newline at end of file
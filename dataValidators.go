@@ -0,0 +1,190 @@
+package woocommerce
+
+import (
+  "context"
+  "strings"
+)
+
+// Store location settings, in the general settings group. Reference: https://woocommerce.com/document/configuring-woocommerce-settings/
+const (
+  settingAllowedCountries         = "woocommerce_allowed_countries"
+  settingAllExceptCountries       = "woocommerce_all_except_countries"
+  settingSpecificAllowedCountries = "woocommerce_specific_allowed_countries"
+  settingShipToCountries          = "woocommerce_ship_to_countries"
+  settingSpecificShipToCountries  = "woocommerce_specific_ship_to_countries"
+)
+
+// Values of the selling (allowed countries) and shipping (ship to countries) location settings.
+// Shipping to an empty value means shipping to the selling locations.
+const (
+  locationsAll       = "all"
+  locationsAllExcept = "all_except"
+  locationsSpecific  = "specific"
+  locationsDisabled  = "disabled"
+)
+
+// ValidationError is returned by the validators when a value is missing or not supported by the store
+type ValidationError struct {
+  Field string
+  Value string
+}
+
+func (err *ValidationError) Error() string {
+  if err.Value == "" {
+    return err.Field + " is missing"
+  }
+
+  return "invalid " + err.Field + " \"" + err.Value + "\""
+}
+
+// ValidateBilling checks the billing address country is one the store sells to, and its state when the
+// country has states. The selling locations are read from the general settings, which needs read access
+// to settings. Enable the service cache to avoid fetching the countries and settings on every call.
+func (service *DataService) ValidateBilling(ctx context.Context, billing *Billing) error {
+  if billing == nil {
+    return &ValidationError{Field: "billing"}
+  }
+
+  return service.validateAddress(ctx, "billing", billing.Country, billing.State)
+}
+
+// ValidateShipping checks the shipping address country is one the store ships to, and its state when the
+// country has states. The shipping locations are read from the general settings, which needs read access
+// to settings. Enable the service cache to avoid fetching the countries and settings on every call.
+func (service *DataService) ValidateShipping(ctx context.Context, shipping *Shipping) error {
+  if shipping == nil {
+    return &ValidationError{Field: "shipping"}
+  }
+
+  return service.validateAddress(ctx, "shipping", shipping.Country, shipping.State)
+}
+
+// ValidateCurrency checks the currency code (eg. an order currency) is known by the store
+func (service *DataService) ValidateCurrency(ctx context.Context, code string) error {
+  if code == "" {
+    return &ValidationError{Field: "currency"}
+  }
+
+  currencies, _, err := service.ListCurrenciesWithContext(ctx)
+  if err != nil {
+    return err
+  }
+
+  for _, currency := range *currencies {
+    if strings.EqualFold(currency.Code, code) {
+      return nil
+    }
+  }
+
+  return &ValidationError{Field: "currency", Value: code}
+}
+
+// validateAddress checks the country exists and is allowed by the store, and the state is one of
+// its states. States are optional in most countries, so an empty state is accepted.
+func (service *DataService) validateAddress(ctx context.Context, addressType string, countryCode string, stateCode string) error {
+  if countryCode == "" {
+    return &ValidationError{Field: addressType + ".country"}
+  }
+
+  countries, _, err := service.ListCountriesWithContext(ctx)
+  if err != nil {
+    return err
+  }
+
+  country := findTerm(*countries, func(country Country) bool {
+    return strings.EqualFold(country.Code, countryCode)
+  })
+
+  if country == nil {
+    return &ValidationError{Field: addressType + ".country", Value: countryCode}
+  }
+
+  allowed, err := service.allowedCountries(ctx, addressType)
+  if err != nil {
+    return err
+  }
+
+  if !allowed(country.Code) {
+    return &ValidationError{Field: addressType + ".country", Value: countryCode}
+  }
+
+  if stateCode == "" || len(country.States) == 0 {
+    return nil
+  }
+
+  for _, state := range country.States {
+    if strings.EqualFold(state.Code, stateCode) {
+      return nil
+    }
+  }
+
+  return &ValidationError{Field: addressType + ".state", Value: stateCode}
+}
+
+// allowedCountries returns a check of the countries the store accepts for the address type, the way
+// WooCommerce restricts checkout: billing addresses to the selling locations, and shipping addresses
+// to the shipping locations (the selling locations, unless shipping has its own setting)
+func (service *DataService) allowedCountries(ctx context.Context, addressType string) (func(code string) bool, error) {
+  options := new([]SettingOption)
+
+  if _, err := service.get(ctx, "/settings/general", options); err != nil {
+    return nil, err
+  }
+
+  settings := make(map[string]interface{}, len(*options))
+
+  for _, option := range *options {
+    settings[option.Id] = option.Value
+  }
+
+  if addressType == "shipping" {
+    switch settingString(settings[settingShipToCountries]) {
+    case locationsAll:
+      return allowAllCountries, nil
+    case locationsSpecific:
+      return allowCountries(settings[settingSpecificShipToCountries], true), nil
+    case locationsDisabled:
+      return allowNoCountry, nil
+    }
+  }
+
+  switch settingString(settings[settingAllowedCountries]) {
+  case locationsAllExcept:
+    return allowCountries(settings[settingAllExceptCountries], false), nil
+  case locationsSpecific:
+    return allowCountries(settings[settingSpecificAllowedCountries], true), nil
+  }
+
+  return allowAllCountries, nil
+}
+
+func allowAllCountries(code string) bool {
+  return true
+}
+
+func allowNoCountry(code string) bool {
+  return false
+}
+
+// allowCountries returns a check of the countries listed in a multi select setting value,
+// or of the countries not listed when listed is false
+func allowCountries(value interface{}, listed bool) func(code string) bool {
+  codes := map[string]bool{}
+
+  if values, ok := value.([]interface{}); ok {
+    for _, code := range values {
+      codes[strings.ToUpper(settingString(code))] = true
+    }
+  }
+
+  return func(code string) bool {
+    return codes[strings.ToUpper(code)] == listed
+  }
+}
+
+// settingString returns a setting value as a string, or an empty string when it is not one
+func settingString(value interface{}) string {
+  text, _ := value.(string)
+
+  return text
+}
@@ -0,0 +1,101 @@
+package woocommerce
+
+import (
+  "context"
+  "fmt"
+  "net/http"
+  "net/http/httptest"
+  "sync"
+  "sync/atomic"
+  "testing"
+)
+
+func newTestDataClient(t *testing.T) (*Client, *atomic.Int32) {
+  var requests atomic.Int32
+
+  server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    requests.Add(1)
+
+    w.Header().Set(headerTotalItems, "2")
+    fmt.Fprint(w, `[{"code":"PT","name":"Portugal","states":[]},{"code":"US","name":"United States","states":[{"code":"CA","name":"California"}]}]`)
+  }))
+
+  t.Cleanup(server.Close)
+
+  client, err := New(server.URL)
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  return client, &requests
+}
+
+func TestDataCacheReturnsCopies(t *testing.T) {
+  client, requests := newTestDataClient(t)
+  client.Data.EnableCache(0)
+
+  first, _, err := client.Data.ListCountries()
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  // Editing a result must not change the cached value
+  (*first)[0].Name = "Edited"
+  (*first)[1].States[0].Name = "Edited"
+
+  second, response, err := client.Data.ListCountries()
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  if requests.Load() != 1 {
+    t.Fatalf("requests = %d, want 1 (second call cached)", requests.Load())
+  }
+
+  if (*second)[0].Name != "Portugal" || (*second)[1].States[0].Name != "California" {
+    t.Errorf("cached countries = %+v, want the original values", *second)
+  }
+
+  (*second)[0].Name = "Edited again"
+
+  third, _, _ := client.Data.ListCountries()
+
+  if (*third)[0].Name != "Portugal" {
+    t.Errorf("cached country = %s, want Portugal", (*third)[0].Name)
+  }
+
+  if response == nil || response.StatusCode != http.StatusOK || response.TotalItems != 2 {
+    t.Errorf("cached response = %+v, want the original response", response)
+  }
+}
+
+func TestDataCacheToggleWhileInUse(t *testing.T) {
+  client, _ := newTestDataClient(t)
+
+  var wait sync.WaitGroup
+
+  for i := 0; i < 8; i++ {
+    wait.Add(1)
+
+    go func(i int) {
+      defer wait.Done()
+
+      for j := 0; j < 20; j++ {
+        switch (i + j) % 4 {
+        case 0:
+          client.Data.EnableCache(0)
+        case 1:
+          client.Data.DisableCache()
+        case 2:
+          client.Data.ClearCache()
+        default:
+          if _, response, err := client.Data.ListCountriesWithContext(context.Background()); err != nil || response == nil {
+            t.Errorf("ListCountries() = %v, %v", response, err)
+          }
+        }
+      }
+    }(i)
+  }
+
+  wait.Wait()
+}
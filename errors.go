@@ -0,0 +1,232 @@
+package woocommerce
+
+import (
+  "encoding/json"
+  "errors"
+  "fmt"
+  "net/http"
+  "sort"
+  "strings"
+)
+
+// errorReasonMissing is the reason given to parameters listed by rest_missing_callback_param
+const errorReasonMissing = "missing"
+
+const (
+  errorCodeInvalidParam = "rest_invalid_param"
+  errorCodeMissingParam = "rest_missing_callback_param"
+  errorCodeTermExists   = "term_exists"
+)
+
+// APIError is returned for any non-2xx API response. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#errors
+type APIError struct {
+  Response *http.Response `json:"-"`
+
+  Code    string    `json:"code"`
+  Message string    `json:"message"`
+  Data    ErrorData `json:"data"`
+}
+
+type ErrorData struct {
+  Status     int                    `json:"status"`
+  Params     map[string]string      `json:"params,omitempty"`
+  Details    map[string]ErrorDetail `json:"details,omitempty"`
+  ResourceID int                    `json:"resource_id,omitempty"`
+}
+
+// UnmarshalJSON decodes the error data. Params are an object of reasons keyed by parameter for
+// rest_invalid_param, but a list of parameter names for rest_missing_callback_param (eg. ["name"]).
+func (data *ErrorData) UnmarshalJSON(b []byte) error {
+  type errorData ErrorData
+
+  var raw struct {
+    errorData
+    Params json.RawMessage `json:"params,omitempty"`
+  }
+
+  if err := json.Unmarshal(b, &raw); err != nil {
+    return err
+  }
+
+  *data = ErrorData(raw.errorData)
+  data.Params = nil
+
+  if len(raw.Params) == 0 || string(raw.Params) == "null" {
+    return nil
+  }
+
+  // List of missing parameters?
+  if raw.Params[0] == '[' {
+    var fields []string
+
+    if err := json.Unmarshal(raw.Params, &fields); err != nil {
+      return err
+    }
+
+    if len(fields) > 0 {
+      data.Params = make(map[string]string, len(fields))
+    }
+
+    for _, field := range fields {
+      data.Params[field] = errorReasonMissing
+    }
+
+    return nil
+  }
+
+  return json.Unmarshal(raw.Params, &data.Params)
+}
+
+type ErrorDetail struct {
+  Code    string      `json:"code,omitempty"`
+  Message string      `json:"message,omitempty"`
+  Data    interface{} `json:"data,omitempty"`
+}
+
+// BatchItemError is the error of a single object in a batch response. Batch requests are answered
+// with HTTP 200 even when some of their objects fail, eg. {"id": 999, "error": {...}}.
+type BatchItemError struct {
+  Code    string    `json:"code"`
+  Message string    `json:"message"`
+  Data    ErrorData `json:"data"`
+}
+
+// BatchError is returned when some objects of a batch request failed. The other objects were applied.
+type BatchError struct {
+  Failures []BatchFailure
+}
+
+// BatchFailure is an object of a batch request that failed. Index is its position in the request,
+// Id is 0 for objects that could not be created.
+type BatchFailure struct {
+  Index int
+  Id    int
+  Err   *BatchItemError
+}
+
+func (apiError *APIError) Error() string {
+  message := apiError.Message
+
+  // Append invalid params? (eg. validation failures)
+  if params := apiError.InvalidParams(); len(params) > 0 {
+    fields := make([]string, 0, len(params))
+
+    for field, reason := range params {
+      fields = append(fields, field+": "+reason)
+    }
+
+    sort.Strings(fields)
+    message += " (" + strings.Join(fields, "; ") + ")"
+  }
+
+  if apiError.Response == nil || apiError.Response.Request == nil {
+    return fmt.Sprintf("%d %v", apiError.StatusCode(), message)
+  }
+
+  return fmt.Sprintf("%v %v: %d %v",
+    apiError.Response.Request.Method, apiError.Response.Request.URL,
+    apiError.StatusCode(), message)
+}
+
+func (itemError *BatchItemError) Error() string {
+  return fmt.Sprintf("%v: %v", itemError.Code, itemError.Message)
+}
+
+func (batchError *BatchError) Error() string {
+  failures := make([]string, 0, len(batchError.Failures))
+
+  for _, failure := range batchError.Failures {
+    if failure.Id != 0 {
+      failures = append(failures, fmt.Sprintf("id %d: %v", failure.Id, failure.Err))
+    } else {
+      failures = append(failures, fmt.Sprintf("item %d: %v", failure.Index, failure.Err))
+    }
+  }
+
+  return "batch items failed: " + strings.Join(failures, "; ")
+}
+
+// IDs returns the IDs of the objects that failed
+func (batchError *BatchError) IDs() []int {
+  ids := make([]int, 0, len(batchError.Failures))
+
+  for _, failure := range batchError.Failures {
+    if failure.Id != 0 {
+      ids = append(ids, failure.Id)
+    }
+  }
+
+  return ids
+}
+
+// StatusCode returns the HTTP status code of the error
+func (apiError *APIError) StatusCode() int {
+  if apiError.Response != nil {
+    return apiError.Response.StatusCode
+  }
+
+  return apiError.Data.Status
+}
+
+// InvalidParams returns the reason for each rejected parameter, keyed by parameter name
+func (apiError *APIError) InvalidParams() map[string]string {
+  if len(apiError.Data.Params) == 0 && len(apiError.Data.Details) == 0 {
+    return nil
+  }
+
+  params := make(map[string]string, len(apiError.Data.Params))
+
+  for field, reason := range apiError.Data.Params {
+    params[field] = reason
+  }
+
+  for field, detail := range apiError.Data.Details {
+    if _, ok := params[field]; !ok {
+      params[field] = detail.Message
+    }
+  }
+
+  return params
+}
+
+// IsNotFound checks if the error is an API error for a missing resource (HTTP 404)
+func IsNotFound(err error) bool {
+  return hasErrorStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized checks if the error is an API error for invalid or missing credentials (HTTP 401)
+func IsUnauthorized(err error) bool {
+  return hasErrorStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden checks if the error is an API error for insufficient permissions (HTTP 403)
+func IsForbidden(err error) bool {
+  return hasErrorStatus(err, http.StatusForbidden)
+}
+
+// IsRateLimited checks if the error is an API error for a rate limited request (HTTP 429)
+func IsRateLimited(err error) bool {
+  return hasErrorStatus(err, http.StatusTooManyRequests)
+}
+
+// IsInvalidParam checks if the error is an API error for invalid or missing request parameters
+func IsInvalidParam(err error) bool {
+  var apiError *APIError
+
+  if !errors.As(err, &apiError) {
+    return false
+  }
+
+  return apiError.Code == errorCodeInvalidParam || apiError.Code == errorCodeMissingParam || len(apiError.Data.Params) > 0
+}
+
+// hasErrorStatus checks if the error is an API error with the given HTTP status
+func hasErrorStatus(err error, status int) bool {
+  var apiError *APIError
+
+  if !errors.As(err, &apiError) {
+    return false
+  }
+
+  return apiError.StatusCode() == status
+}
@@ -0,0 +1,178 @@
+package woocommerce
+
+import (
+  "encoding/json"
+  "errors"
+  "fmt"
+  "net/http"
+  "net/http/httptest"
+  "reflect"
+  "strings"
+  "testing"
+)
+
+func TestAPIErrorFromResponse(t *testing.T) {
+  tests := []struct {
+    name          string
+    status        int
+    body          string
+    code          string
+    invalidParams map[string]string
+    messageParts  []string
+    notFound      bool
+    unauthorized  bool
+    forbidden     bool
+    invalidParam  bool
+  }{
+    {
+      name:     "invalid id",
+      status:   http.StatusNotFound,
+      body:     `{"code":"woocommerce_rest_invalid_id","message":"Invalid ID.","data":{"status":404}}`,
+      code:     "woocommerce_rest_invalid_id",
+      notFound: true,
+    },
+    {
+      name:   "invalid param",
+      status: http.StatusBadRequest,
+      body: `{"code":"rest_invalid_param","message":"Invalid parameter(s): status, per_page","data":{"status":400,` +
+        `"params":{"status":"status is not one of pending, processing, on-hold, completed, cancelled, refunded, failed and trash.","per_page":"per_page must be between 1 (inclusive) and 100 (inclusive)"},` +
+        `"details":{"status":{"code":"rest_not_in_enum","message":"status is not one of pending, processing, on-hold, completed, cancelled, refunded, failed and trash.","data":null}}}}`,
+      code: "rest_invalid_param",
+      invalidParams: map[string]string{
+        "status":   "status is not one of pending, processing, on-hold, completed, cancelled, refunded, failed and trash.",
+        "per_page": "per_page must be between 1 (inclusive) and 100 (inclusive)",
+      },
+      messageParts: []string{"per_page: per_page must be between", "status: status is not one of"},
+      invalidParam: true,
+    },
+    {
+      name:          "missing params",
+      status:        http.StatusBadRequest,
+      body:          `{"code":"rest_missing_callback_param","message":"Missing parameter(s): name, type","data":{"status":400,"params":["name","type"]}}`,
+      code:          "rest_missing_callback_param",
+      invalidParams: map[string]string{"name": errorReasonMissing, "type": errorReasonMissing},
+      messageParts:  []string{"(name: missing; type: missing)"},
+      invalidParam:  true,
+    },
+    {
+      name:         "cannot view",
+      status:       http.StatusUnauthorized,
+      body:         `{"code":"woocommerce_rest_cannot_view","message":"Sorry, you cannot list resources.","data":{"status":401}}`,
+      code:         "woocommerce_rest_cannot_view",
+      unauthorized: true,
+    },
+    {
+      name:      "cannot view without capability",
+      status:    http.StatusForbidden,
+      body:      `{"code":"woocommerce_rest_cannot_view","message":"Sorry, you cannot view this resource.","data":{"status":403}}`,
+      code:      "woocommerce_rest_cannot_view",
+      forbidden: true,
+    },
+    {
+      name:     "not json",
+      status:   http.StatusNotFound,
+      body:     `<html>Not Found</html>`,
+      notFound: true,
+    },
+  }
+
+  for _, test := range tests {
+    t.Run(test.name, func(t *testing.T) {
+      server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.WriteHeader(test.status)
+        fmt.Fprint(w, test.body)
+      }))
+      defer server.Close()
+
+      client, err := New(server.URL, WithRetryPolicy(NoRetryPolicy()))
+      if err != nil {
+        t.Fatal(err)
+      }
+
+      _, _, err = client.Orders.List(nil)
+
+      var apiError *APIError
+
+      if !errors.As(err, &apiError) {
+        t.Fatalf("List() error = %v, want an *APIError", err)
+      }
+
+      if apiError.Code != test.code || apiError.StatusCode() != test.status || apiError.Data.Status != test.status {
+        t.Errorf("APIError = %s (%d, data %d), want %s (%d)", apiError.Code, apiError.StatusCode(), apiError.Data.Status, test.code, test.status)
+      }
+
+      if got := apiError.InvalidParams(); !reflect.DeepEqual(got, test.invalidParams) {
+        t.Errorf("InvalidParams() = %v, want %v", got, test.invalidParams)
+      }
+
+      for _, part := range test.messageParts {
+        if !strings.Contains(err.Error(), part) {
+          t.Errorf("Error() = %q, want it to contain %q", err.Error(), part)
+        }
+      }
+
+      predicates := []struct {
+        name string
+        got  bool
+        want bool
+      }{
+        {"IsNotFound", IsNotFound(err), test.notFound},
+        {"IsUnauthorized", IsUnauthorized(err), test.unauthorized},
+        {"IsForbidden", IsForbidden(err), test.forbidden},
+        {"IsRateLimited", IsRateLimited(err), false},
+        {"IsInvalidParam", IsInvalidParam(err), test.invalidParam},
+      }
+
+      for _, predicate := range predicates {
+        if predicate.got != predicate.want {
+          t.Errorf("%s() = %v, want %v", predicate.name, predicate.got, predicate.want)
+        }
+      }
+    })
+  }
+}
+
+func TestErrorDataUnmarshalJSON(t *testing.T) {
+  tests := []struct {
+    name    string
+    data    string
+    want    ErrorData
+    wantErr bool
+  }{
+    {name: "no params", data: `{"status":404}`, want: ErrorData{Status: 404}},
+    {name: "null params", data: `{"status":400,"params":null}`, want: ErrorData{Status: 400}},
+    {name: "empty list", data: `{"status":400,"params":[]}`, want: ErrorData{Status: 400}},
+    {name: "object", data: `{"status":400,"params":{"email":"Invalid email."}}`, want: ErrorData{Status: 400, Params: map[string]string{"email": "Invalid email."}}},
+    {name: "list", data: `{"status":400,"params":["name"]}`, want: ErrorData{Status: 400, Params: map[string]string{"name": errorReasonMissing}}},
+    {name: "resource id", data: `{"status":400,"resource_id":12}`, want: ErrorData{Status: 400, ResourceID: 12}},
+    {name: "invalid params", data: `{"status":400,"params":"name"}`, wantErr: true},
+  }
+
+  for _, test := range tests {
+    t.Run(test.name, func(t *testing.T) {
+      var data ErrorData
+
+      err := json.Unmarshal([]byte(test.data), &data)
+
+      if (err != nil) != test.wantErr {
+        t.Fatalf("Unmarshal() error = %v, want error %v", err, test.wantErr)
+      }
+
+      if !test.wantErr && !reflect.DeepEqual(data, test.want) {
+        t.Errorf("Unmarshal() = %+v, want %+v", data, test.want)
+      }
+    })
+  }
+}
+
+func TestPredicatesOnOtherErrors(t *testing.T) {
+  for _, err := range []error{nil, errors.New("connection refused"), fmt.Errorf("wrapped: %w", &APIError{Data: ErrorData{Status: http.StatusTooManyRequests}})} {
+    if IsNotFound(err) || IsUnauthorized(err) || IsForbidden(err) || IsInvalidParam(err) {
+      t.Errorf("predicates matched %v", err)
+    }
+  }
+
+  if !IsRateLimited(fmt.Errorf("wrapped: %w", &APIError{Data: ErrorData{Status: http.StatusTooManyRequests}})) {
+    t.Error("IsRateLimited() = false for a wrapped HTTP 429 error")
+  }
+}
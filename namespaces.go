@@ -0,0 +1,68 @@
+package woocommerce
+
+import (
+  "context"
+  "strings"
+)
+
+// REST API namespaces. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#introduction
+const (
+  NamespaceV1        = "wc/v1"
+  NamespaceV2        = "wc/v2"
+  NamespaceV3        = "wc/v3"
+  NamespaceAnalytics = "wc-analytics"
+  NamespaceStoreV1   = "wc/store/v1"
+)
+
+type namespaceContextKey struct{}
+
+// rawNamespace is a namespace used as given, without mapping versions to "wc/" (eg. "bookings")
+type rawNamespace string
+
+// ContextWithNamespace returns a context that sends requests created with it to the
+// given namespace (eg. NamespaceV2), instead of the client namespace
+func ContextWithNamespace(ctx context.Context, namespace string) context.Context {
+  return context.WithValue(ctx, namespaceContextKey{}, namespace)
+}
+
+// contextWithRawNamespace returns a context that sends requests created with it to the
+// given namespace, as is
+func contextWithRawNamespace(ctx context.Context, namespace string) context.Context {
+  return context.WithValue(ctx, namespaceContextKey{}, rawNamespace(namespace))
+}
+
+// namespaceFromContext returns the namespace set on the context, if any
+func namespaceFromContext(ctx context.Context) (string, bool) {
+  namespace, ok := ctx.Value(namespaceContextKey{}).(string)
+
+  return namespace, ok && namespace != ""
+}
+
+// Namespace returns the namespace requests are sent to by default (eg. "wc/v3")
+func (client *Client) Namespace() string {
+  return normalizeNamespace(client.config.RestEndpointVersion)
+}
+
+// namespace returns the namespace of a request created with the given context
+func (client *Client) namespace(ctx context.Context) string {
+  if namespace, ok := ctx.Value(namespaceContextKey{}).(rawNamespace); ok && namespace != "" {
+    return strings.Trim(string(namespace), "/")
+  }
+
+  if namespace, ok := namespaceFromContext(ctx); ok {
+    return normalizeNamespace(namespace)
+  }
+
+  return client.Namespace()
+}
+
+// normalizeNamespace maps a version (eg. "v2") to its namespace, keeping full namespaces as is
+func normalizeNamespace(version string) string {
+  version = strings.Trim(version, "/")
+
+  if strings.Contains(version, "/") || strings.Contains(version, "-") {
+    return version
+  }
+
+  return "wc/" + version
+}
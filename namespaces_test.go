@@ -0,0 +1,114 @@
+package woocommerce
+
+import (
+  "context"
+  "encoding/json"
+  "fmt"
+  "io"
+  "net/http"
+  "net/http/httptest"
+  "testing"
+)
+
+func TestLegacyModelsAgainstNamespaceV2(t *testing.T) {
+  var path string
+  var body map[string]interface{}
+
+  server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    path = r.URL.Path
+
+    switch r.Method {
+    case http.MethodPut:
+      data, _ := io.ReadAll(r.Body)
+      body = nil
+
+      if err := json.Unmarshal(data, &body); err != nil {
+        t.Errorf("decoding request body: %v", err)
+      }
+
+      fmt.Fprint(w, `{"id":42,"in_stock":false}`)
+    default:
+      fmt.Fprint(w, `{"id":7,"orders_count":3,"total_spent":"120.50","last_order":{"id":99,"date":"2017-03-21T16:09:28"}}`)
+    }
+  }))
+  defer server.Close()
+
+  client, err := New(server.URL)
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  ctx := ContextWithNamespace(context.Background(), NamespaceV2)
+  inStock := false
+
+  product, _, err := client.Products.UpdateWithContext(ctx, "42", &Product{InStock: &inStock})
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  if path != "/wp-json/wc/v2/products/42" {
+    t.Errorf("path = %s, want /wp-json/wc/v2/products/42", path)
+  }
+
+  if value, ok := body["in_stock"]; !ok || value != false {
+    t.Errorf("request in_stock = %v (sent %v), want false", value, ok)
+  }
+
+  if product.InStock == nil || *product.InStock {
+    t.Errorf("InStock = %v, want false", product.InStock)
+  }
+
+  // A product without the legacy field set does not send it
+  client.Products.UpdateWithContext(ctx, "42", &Product{Name: "Hoodie"})
+
+  if _, ok := body["in_stock"]; ok {
+    t.Errorf("request body = %v, want no in_stock", body)
+  }
+
+  customer, _, err := client.Customers.GetWithContext(ContextWithNamespace(context.Background(), "v1"), "7")
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  if path != "/wp-json/wc/v1/customers/7" {
+    t.Errorf("path = %s, want /wp-json/wc/v1/customers/7", path)
+  }
+
+  if customer.OrdersCount != 3 || customer.TotalSpent != "120.50" || customer.LastOrder == nil || customer.LastOrder.ID != 99 {
+    t.Errorf("customer = %+v, want the legacy fields", customer)
+  }
+}
+
+func TestNamespaceResolution(t *testing.T) {
+  tests := []struct {
+    name   string
+    client string
+    ctx    context.Context
+    want   string
+  }{
+    {name: "default", ctx: context.Background(), want: NamespaceV3},
+    {name: "client version", client: "v2", ctx: context.Background(), want: NamespaceV2},
+    {name: "call version", client: "v2", ctx: ContextWithNamespace(context.Background(), "v1"), want: NamespaceV1},
+    {name: "call namespace", ctx: ContextWithNamespace(context.Background(), NamespaceAnalytics), want: NamespaceAnalytics},
+    {name: "call namespace with slashes", ctx: ContextWithNamespace(context.Background(), "/wc/store/v1/"), want: NamespaceStoreV1},
+    {name: "raw namespace", ctx: contextWithRawNamespace(context.Background(), "bookings"), want: "bookings"},
+  }
+
+  for _, test := range tests {
+    t.Run(test.name, func(t *testing.T) {
+      opts := []ClientOption{}
+      if test.client != "" {
+        opts = append(opts, WithAPIVersion(test.client))
+      }
+
+      client, err := New("https://shop.test", opts...)
+      if err != nil {
+        t.Fatal(err)
+      }
+
+      if got := client.namespace(test.ctx); got != test.want {
+        t.Errorf("namespace() = %s, want %s", got, test.want)
+      }
+    })
+  }
+}
@@ -0,0 +1,166 @@
+package woocommerce
+
+import (
+  "crypto/hmac"
+  "crypto/rand"
+  "crypto/sha1"
+  "crypto/sha256"
+  "encoding/base64"
+  "encoding/hex"
+  "errors"
+  "hash"
+  "net/http"
+  "net/url"
+  "sort"
+  "strconv"
+  "strings"
+  "time"
+)
+
+// OAuth 1.0a signature methods supported by WooCommerce
+const (
+  OAuthSignatureHMACSHA1   = "HMAC-SHA1"
+  OAuthSignatureHMACSHA256 = "HMAC-SHA256"
+)
+
+const (
+  oauthVersion        = "1.0"
+  oauthSignatureParam = "oauth_signature"
+  oauthParamPrefix    = "oauth_"
+)
+
+var errorOAuthSignatureMethod = errors.New("unsupported oauth signature method")
+
+// oauthSigner signs requests with one-legged OAuth 1.0a, as required by WooCommerce over plain HTTP.
+// Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#authentication-over-http
+type oauthSigner struct {
+  consumerKey     string
+  consumerSecret  string
+  signatureMethod string
+
+  now   func() time.Time
+  nonce func() (string, error)
+}
+
+func newOAuthSigner(consumerKey, consumerSecret, signatureMethod string) *oauthSigner {
+  if signatureMethod == "" {
+    signatureMethod = OAuthSignatureHMACSHA256
+  }
+
+  return &oauthSigner{
+    consumerKey:     consumerKey,
+    consumerSecret:  consumerSecret,
+    signatureMethod: signatureMethod,
+    now:             time.Now,
+    nonce:           oauthNonce,
+  }
+}
+
+// sign adds the oauth parameters and signature to the request query string,
+// replacing any previous ones (eg. when a request is retried)
+func (signer *oauthSigner) sign(req *http.Request) error {
+  nonce, err := signer.nonce()
+  if err != nil {
+    return err
+  }
+
+  params := url.Values{}
+
+  for key, values := range req.URL.Query() {
+    if !strings.HasPrefix(key, oauthParamPrefix) {
+      params[key] = values
+    }
+  }
+
+  params.Set("oauth_consumer_key", signer.consumerKey)
+  params.Set("oauth_nonce", nonce)
+  params.Set("oauth_signature_method", signer.signatureMethod)
+  params.Set("oauth_timestamp", strconv.FormatInt(signer.now().Unix(), 10))
+  params.Set("oauth_version", oauthVersion)
+
+  signature, err := signer.signature(signer.signatureBaseString(req.Method, req.URL, params))
+  if err != nil {
+    return err
+  }
+
+  params.Set(oauthSignatureParam, signature)
+  req.URL.RawQuery = params.Encode()
+
+  return nil
+}
+
+// signatureBaseString builds the string to sign. Parameters are normalised the
+// way WooCommerce verifies them: keys are sorted, each key and value is RFC 3986
+// encoded, and every "key=value" pair is encoded again before being joined with
+// an encoded "&" (so a space in a value ends up as "%2520").
+func (signer *oauthSigner) signatureBaseString(method string, requestURL *url.URL, params url.Values) string {
+  baseURL := url.URL{Scheme: strings.ToLower(requestURL.Scheme), Host: strings.ToLower(requestURL.Host), Path: requestURL.Path}
+
+  keys := make([]string, 0, len(params))
+
+  for key := range params {
+    if key != oauthSignatureParam {
+      keys = append(keys, key)
+    }
+  }
+
+  sort.Strings(keys)
+
+  pairs := make([]string, 0, len(keys))
+
+  for _, key := range keys {
+    for _, value := range params[key] {
+      pairs = append(pairs, oauthEscape(oauthEscape(key)+"="+oauthEscape(value)))
+    }
+  }
+
+  return strings.ToUpper(method) + "&" + oauthEscape(baseURL.String()) + "&" + strings.Join(pairs, "%26")
+}
+
+// signature signs the base string with the consumer secret
+func (signer *oauthSigner) signature(baseString string) (string, error) {
+  var hashFunc func() hash.Hash
+
+  switch signer.signatureMethod {
+  case OAuthSignatureHMACSHA1:
+    hashFunc = sha1.New
+  case OAuthSignatureHMACSHA256:
+    hashFunc = sha256.New
+  default:
+    return "", errorOAuthSignatureMethod
+  }
+
+  // WooCommerce signs with an empty token secret
+  mac := hmac.New(hashFunc, []byte(signer.consumerSecret+"&"))
+  mac.Write([]byte(baseString))
+
+  return base64.StdEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// oauthEscape percent-encodes a string as per RFC 3986 (unreserved characters are kept)
+func oauthEscape(value string) string {
+  var builder strings.Builder
+
+  for i := 0; i < len(value); i++ {
+    c := value[i]
+
+    if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') || c == '-' || c == '.' || c == '_' || c == '~' {
+      builder.WriteByte(c)
+    } else {
+      builder.WriteString("%" + strings.ToUpper(hex.EncodeToString([]byte{c})))
+    }
+  }
+
+  return builder.String()
+}
+
+// oauthNonce returns a random nonce
+func oauthNonce() (string, error) {
+  nonce := make([]byte, 16)
+
+  if _, err := rand.Read(nonce); err != nil {
+    return "", err
+  }
+
+  return hex.EncodeToString(nonce), nil
+}
@@ -0,0 +1,156 @@
+package woocommerce
+
+import (
+  "net/http"
+  "net/url"
+  "testing"
+  "time"
+)
+
+const (
+  testOAuthConsumerKey    = "ck_4f0c5a"
+  testOAuthConsumerSecret = "cs_9e2d1b"
+  testOAuthEndpoint       = "http://shop.test/wp-json/wc/v3/products"
+)
+
+// testOAuthQuery holds reserved characters that WooCommerce encodes twice when verifying
+var testOAuthQuery = url.Values{"per_page": {"10"}, "search": {"a b+c&d=e/f~"}}
+
+func newTestOAuthSigner(signatureMethod string, nonces ...string) *oauthSigner {
+  signer := newOAuthSigner(testOAuthConsumerKey, testOAuthConsumerSecret, signatureMethod)
+  signer.now = func() time.Time { return time.Unix(1700000000, 0) }
+  signer.nonce = func() (string, error) {
+    nonce := nonces[0]
+
+    if len(nonces) > 1 {
+      nonces = nonces[1:]
+    }
+
+    return nonce, nil
+  }
+
+  return signer
+}
+
+func newTestOAuthRequest(t *testing.T) *http.Request {
+  req, err := http.NewRequest(http.MethodGet, testOAuthEndpoint+"?"+testOAuthQuery.Encode(), nil)
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  return req
+}
+
+// Expected values follow WooCommerce's WC_REST_Authentication::check_oauth_signature
+func TestOAuthSignatureVectors(t *testing.T) {
+  tests := []struct {
+    method     string
+    baseString string
+    signature  string
+  }{
+    {
+      method:     OAuthSignatureHMACSHA1,
+      baseString: "GET&http%3A%2F%2Fshop.test%2Fwp-json%2Fwc%2Fv3%2Fproducts&oauth_consumer_key%3Dck_4f0c5a%26oauth_nonce%3D3f1c2b%26oauth_signature_method%3DHMAC-SHA1%26oauth_timestamp%3D1700000000%26oauth_version%3D1.0%26per_page%3D10%26search%3Da%2520b%252Bc%2526d%253De%252Ff~",
+      signature:  "DgSsqMijCoMuvCzoVN92/N/2mCk=",
+    },
+    {
+      method:     OAuthSignatureHMACSHA256,
+      baseString: "GET&http%3A%2F%2Fshop.test%2Fwp-json%2Fwc%2Fv3%2Fproducts&oauth_consumer_key%3Dck_4f0c5a%26oauth_nonce%3D3f1c2b%26oauth_signature_method%3DHMAC-SHA256%26oauth_timestamp%3D1700000000%26oauth_version%3D1.0%26per_page%3D10%26search%3Da%2520b%252Bc%2526d%253De%252Ff~",
+      signature:  "fINWqUWSRd9MbAuDHfMf4+X0uI7Y0Png2lAvr6cNY2A=",
+    },
+  }
+
+  for _, test := range tests {
+    t.Run(test.method, func(t *testing.T) {
+      signer := newTestOAuthSigner(test.method, "3f1c2b")
+      req := newTestOAuthRequest(t)
+
+      params := url.Values{
+        "oauth_consumer_key":     {testOAuthConsumerKey},
+        "oauth_nonce":            {"3f1c2b"},
+        "oauth_signature_method": {test.method},
+        "oauth_timestamp":        {"1700000000"},
+        "oauth_version":          {"1.0"},
+        "per_page":               {"10"},
+        "search":                 {"a b+c&d=e/f~"},
+      }
+
+      if baseString := signer.signatureBaseString(req.Method, req.URL, params); baseString != test.baseString {
+        t.Errorf("signatureBaseString() =\n%s\nwant\n%s", baseString, test.baseString)
+      }
+
+      signature, err := signer.signature(test.baseString)
+      if err != nil {
+        t.Fatal(err)
+      }
+
+      if signature != test.signature {
+        t.Errorf("signature() = %s, want %s", signature, test.signature)
+      }
+
+      if err := signer.sign(req); err != nil {
+        t.Fatal(err)
+      }
+
+      query := req.URL.Query()
+
+      if got := query.Get(oauthSignatureParam); got != test.signature {
+        t.Errorf("signed oauth_signature = %s, want %s", got, test.signature)
+      }
+
+      if got := query.Get("search"); got != "a b+c&d=e/f~" {
+        t.Errorf("signed search = %q, want the original value", got)
+      }
+    })
+  }
+}
+
+func TestOAuthResignReplacesPreviousParams(t *testing.T) {
+  signer := newTestOAuthSigner(OAuthSignatureHMACSHA256, "3f1c2b", "8a7d6e")
+  req := newTestOAuthRequest(t)
+
+  if err := signer.sign(req); err != nil {
+    t.Fatal(err)
+  }
+
+  first := req.URL.Query().Get(oauthSignatureParam)
+
+  // A retried request is signed again on the same URL
+  if err := signer.sign(req); err != nil {
+    t.Fatal(err)
+  }
+
+  query := req.URL.Query()
+
+  for _, key := range []string{"oauth_consumer_key", "oauth_nonce", "oauth_signature_method", "oauth_timestamp", "oauth_version", oauthSignatureParam} {
+    if len(query[key]) != 1 {
+      t.Errorf("%s = %v, want exactly one value", key, query[key])
+    }
+  }
+
+  if got := query.Get("oauth_nonce"); got != "8a7d6e" {
+    t.Errorf("oauth_nonce = %s, want the new nonce", got)
+  }
+
+  if query.Get(oauthSignatureParam) == first {
+    t.Error("oauth_signature was not recomputed for the new nonce")
+  }
+
+  // The old signature must not have been part of the new base string
+  params := url.Values{}
+
+  for key, values := range query {
+    if key != oauthSignatureParam {
+      params[key] = values
+    }
+  }
+
+  want, err := signer.signature(signer.signatureBaseString(req.Method, req.URL, params))
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  if got := query.Get(oauthSignatureParam); got != want {
+    t.Errorf("oauth_signature = %s, want %s", got, want)
+  }
+}
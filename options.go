@@ -0,0 +1,58 @@
+package woocommerce
+
+import (
+  "net/http"
+  "time"
+)
+
+// ClientOption configures a client created with New
+type ClientOption func(config *ClientConfig)
+
+// WithHTTPClient sets the HTTP client used to send requests
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+  return func(config *ClientConfig) {
+    config.HttpClient = httpClient
+  }
+}
+
+// WithAPIVersion sets the REST API version (eg. "v2") or namespace (eg. NamespaceAnalytics)
+func WithAPIVersion(version string) ClientOption {
+  return func(config *ClientConfig) {
+    config.RestEndpointVersion = version
+  }
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) ClientOption {
+  return func(config *ClientConfig) {
+    config.UserAgent = userAgent
+  }
+}
+
+// WithTimeout sets the HTTP client timeout, for each request attempt
+func WithTimeout(timeout time.Duration) ClientOption {
+  return func(config *ClientConfig) {
+    config.Timeout = timeout
+  }
+}
+
+// WithBaseHeaders sets headers sent with every request
+func WithBaseHeaders(headers http.Header) ClientOption {
+  return func(config *ClientConfig) {
+    config.BaseHeaders = headers.Clone()
+  }
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests (nil uses DefaultRetryPolicy)
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+  return func(config *ClientConfig) {
+    config.RetryPolicy = policy
+  }
+}
+
+// WithAuthenticator sets the strategy used to authenticate requests
+func WithAuthenticator(authenticator Authenticator) ClientOption {
+  return func(config *ClientConfig) {
+    config.Authenticator = authenticator
+  }
+}
@@ -1,7 +1,7 @@
 package woocommerce
 
 import (
-  "net/http"
+  "context"
 )
 
 // Order Notes service
@@ -19,8 +19,8 @@ type OrderNote struct {
 }
 
 type ListOrderNotesParams struct {
-  Context  string      `json:"context,omitempty"`
-  Type     string      `json:"type,omitempty"`
+  Context  string      `url:"context,omitempty"`
+  Type     string      `url:"type,omitempty"`
 }
 
 type DeleteOrderNoteParams struct {
@@ -28,9 +28,14 @@ type DeleteOrderNoteParams struct {
 }
 
 // Create an order Note. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-an-order-note
-func (service *OrderNotesService) Create(orderId string, orderNote *OrderNote) (*OrderNote, *http.Response, error) {
+func (service *OrderNotesService) Create(orderId string, orderNote *OrderNote) (*OrderNote, *Response, error) {
+  return service.CreateWithContext(context.Background(), orderId, orderNote)
+}
+
+// Create an order Note with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-an-order-note
+func (service *OrderNotesService) CreateWithContext(ctx context.Context, orderId string, orderNote *OrderNote) (*OrderNote, *Response, error) {
   _url := "/orders/" + orderId + "/notes"
-  req, _ := service.client.NewRequest("POST", _url, nil, orderNote)
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, orderNote)
 
   createdOrder := new(OrderNote)
   response, err := service.client.Do(req, createdOrder)
@@ -43,9 +48,14 @@ func (service *OrderNotesService) Create(orderId string, orderNote *OrderNote) (
 }
 
 // Get an order Note. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-an-order-note
-func (service *OrderNotesService) Get(orderId string, noteId string) (*OrderNote, *http.Response, error) {
+func (service *OrderNotesService) Get(orderId string, noteId string) (*OrderNote, *Response, error) {
+  return service.GetWithContext(context.Background(), orderId, noteId)
+}
+
+// Get an order Note with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-an-order-note
+func (service *OrderNotesService) GetWithContext(ctx context.Context, orderId string, noteId string) (*OrderNote, *Response, error) {
   _url := "/orders/" + orderId + "/notes/" + noteId
-  req, _ := service.client.NewRequest("GET", _url, nil, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
 
   orderNote := new(OrderNote)
   response, err := service.client.Do(req, orderNote)
@@ -58,9 +68,14 @@ func (service *OrderNotesService) Get(orderId string, noteId string) (*OrderNote
 }
 
 // List order Notes. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-order-notes
-func (service *OrderNotesService) List(orderId string, opts *ListOrderNotesParams) (*[]OrderNote, *http.Response, error) {
+func (service *OrderNotesService) List(orderId string, opts *ListOrderNotesParams) (*[]OrderNote, *Response, error) {
+  return service.ListWithContext(context.Background(), orderId, opts)
+}
+
+// List order Notes with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-order-notes
+func (service *OrderNotesService) ListWithContext(ctx context.Context, orderId string, opts *ListOrderNotesParams) (*[]OrderNote, *Response, error) {
   _url := "/orders/" + orderId + "/notes"
-  req, _ := service.client.NewRequest("GET", _url, opts, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
 
   orders := new([]OrderNote)
   response, err := service.client.Do(req, orders)
@@ -73,9 +88,14 @@ func (service *OrderNotesService) List(orderId string, opts *ListOrderNotesParam
 }
 
 // Delete an order Note. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-an-order-note
-func (service *OrderNotesService) Delete(orderId string, noteId string, opts *DeleteOrderParams) (*OrderNote, *http.Response, error) {
+func (service *OrderNotesService) Delete(orderId string, noteId string, opts *DeleteOrderParams) (*OrderNote, *Response, error) {
+  return service.DeleteWithContext(context.Background(), orderId, noteId, opts)
+}
+
+// Delete an order Note with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-an-order-note
+func (service *OrderNotesService) DeleteWithContext(ctx context.Context, orderId string, noteId string, opts *DeleteOrderParams) (*OrderNote, *Response, error) {
   _url := "/orders/" + orderId + "/notes/" + noteId
-  req, _ := service.client.NewRequest("DELETE", _url, opts, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
 
   orderNote := new(OrderNote)
   response, err := service.client.Do(req, orderNote)
@@ -85,4 +105,28 @@ func (service *OrderNotesService) Delete(orderId string, noteId string, opts *De
   }
 
   return orderNote, response, nil
+}
+
+// Paginate order notes (the endpoint returns every note in a single page)
+func (service *OrderNotesService) Paginate(orderId string, opts *ListOrderNotesParams) *Paginator[OrderNote] {
+  params := ListOrderNotesParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(1, func(ctx context.Context, _ int) ([]OrderNote, *Response, error) {
+    orderNotes, response, err := service.ListWithContext(ctx, orderId, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *orderNotes, response, nil
+  })
+}
+
+// List all order notes across every page
+func (service *OrderNotesService) ListAll(ctx context.Context, orderId string, opts *ListOrderNotesParams) ([]OrderNote, error) {
+  return service.Paginate(orderId, opts).Collect(ctx)
 }
\ No newline at end of file
@@ -1,7 +1,7 @@
 package woocommerce
 
 import (
-  "net/http"
+  "context"
 )
 
 // Orders service
@@ -180,9 +180,14 @@ type BatchOrderUpdateResponse struct {
 }
 
 // Create an order. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-an-order
-func (service *OrdersService) Create(order *Order) (*Order, *http.Response, error) {
+func (service *OrdersService) Create(order *Order) (*Order, *Response, error) {
+  return service.CreateWithContext(context.Background(), order)
+}
+
+// Create an order with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-an-order
+func (service *OrdersService) CreateWithContext(ctx context.Context, order *Order) (*Order, *Response, error) {
   _url := "/orders"
-  req, _ := service.client.NewRequest("POST", _url, nil, order)
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, order)
 
   createdOrder := new(Order)
   response, err := service.client.Do(req, createdOrder)
@@ -195,9 +200,14 @@ func (service *OrdersService) Create(order *Order) (*Order, *http.Response, erro
 }
 
 // Get an order. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-an-order
-func (service *OrdersService) Get(orderId string , opts *GetOrderParams) (*Order, *http.Response, error) {
+func (service *OrdersService) Get(orderId string , opts *GetOrderParams) (*Order, *Response, error) {
+  return service.GetWithContext(context.Background(), orderId, opts)
+}
+
+// Get an order with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-an-order
+func (service *OrdersService) GetWithContext(ctx context.Context, orderId string , opts *GetOrderParams) (*Order, *Response, error) {
   _url := "/orders/" + orderId
-  req, _ := service.client.NewRequest("GET", _url, opts, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
 
   order := new(Order)
   response, err := service.client.Do(req, order)
@@ -210,9 +220,14 @@ func (service *OrdersService) Get(orderId string , opts *GetOrderParams) (*Order
 }
 
 // List orders. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-orders
-func (service *OrdersService) List(opts *ListOrdersParams) (*[]Order, *http.Response, error) {
+func (service *OrdersService) List(opts *ListOrdersParams) (*[]Order, *Response, error) {
+  return service.ListWithContext(context.Background(), opts)
+}
+
+// List orders with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-orders
+func (service *OrdersService) ListWithContext(ctx context.Context, opts *ListOrdersParams) (*[]Order, *Response, error) {
   _url := "/orders"
-  req, _ := service.client.NewRequest("GET", _url, opts, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
 
   orders := new([]Order)
   response, err := service.client.Do(req, orders)
@@ -225,9 +240,14 @@ func (service *OrdersService) List(opts *ListOrdersParams) (*[]Order, *http.Resp
 }
 
 // Update an order. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-an-order
-func (service *OrdersService) Update(orderId string , order *Order) (*Order, *http.Response, error) {
+func (service *OrdersService) Update(orderId string , order *Order) (*Order, *Response, error) {
+  return service.UpdateWithContext(context.Background(), orderId, order)
+}
+
+// Update an order with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-an-order
+func (service *OrdersService) UpdateWithContext(ctx context.Context, orderId string , order *Order) (*Order, *Response, error) {
   _url := "/orders/" + orderId
-  req, _ := service.client.NewRequest("PUT", _url, nil, order)
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, order)
 
   updatedOrder := new(Order)
   response, err := service.client.Do(req, updatedOrder)
@@ -240,9 +260,14 @@ func (service *OrdersService) Update(orderId string , order *Order) (*Order, *ht
 }
 
 // Delete an order. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-an-order
-func (service *OrdersService) Delete(orderId string , opts *DeleteOrderParams) (*Order, *http.Response, error) {
+func (service *OrdersService) Delete(orderId string , opts *DeleteOrderParams) (*Order, *Response, error) {
+  return service.DeleteWithContext(context.Background(), orderId, opts)
+}
+
+// Delete an order with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-an-order
+func (service *OrdersService) DeleteWithContext(ctx context.Context, orderId string , opts *DeleteOrderParams) (*Order, *Response, error) {
   _url := "/orders/" + orderId
-  req, _ := service.client.NewRequest("DELETE", _url, opts, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
 
   order := new(Order)
   response, err := service.client.Do(req, order)
@@ -255,9 +280,14 @@ func (service *OrdersService) Delete(orderId string , opts *DeleteOrderParams) (
 }
 
 // Batch update orders. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-orders
-func (service *OrdersService) Batch(opts *BatchOrderUpdate) (*BatchOrderUpdateResponse, *http.Response, error) {
+func (service *OrdersService) Batch(opts *BatchOrderUpdate) (*BatchOrderUpdateResponse, *Response, error) {
+  return service.BatchWithContext(context.Background(), opts)
+}
+
+// Batch update orders with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-orders
+func (service *OrdersService) BatchWithContext(ctx context.Context, opts *BatchOrderUpdate) (*BatchOrderUpdateResponse, *Response, error) {
   _url := "/orders/batch"
-  req, _ := service.client.NewRequest("POST", _url, opts, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, opts)
 
   orders := new(BatchOrderUpdateResponse)
   response, err := service.client.Do(req, orders)
@@ -267,4 +297,29 @@ func (service *OrdersService) Batch(opts *BatchOrderUpdate) (*BatchOrderUpdateRe
   }
 
   return orders, response, nil
+}
+
+// Paginate orders, fetching each page as it is iterated
+func (service *OrdersService) Paginate(opts *ListOrdersParams) *Paginator[Order] {
+  params := ListOrdersParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]Order, *Response, error) {
+    params.Page = page
+    orders, response, err := service.ListWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *orders, response, nil
+  })
+}
+
+// List all orders across every page
+func (service *OrdersService) ListAll(ctx context.Context, opts *ListOrdersParams) ([]Order, error) {
+  return service.Paginate(opts).Collect(ctx)
 }
\ No newline at end of file
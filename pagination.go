@@ -0,0 +1,91 @@
+package woocommerce
+
+import (
+  "context"
+  "iter"
+)
+
+// Paginator walks every page of a list endpoint
+type Paginator[T any] struct {
+  firstPage  int
+  fetch      func(ctx context.Context, page int) ([]T, *Response, error)
+  totalItems int
+  totalPages int
+}
+
+func newPaginator[T any](firstPage int, fetch func(ctx context.Context, page int) ([]T, *Response, error)) *Paginator[T] {
+  if firstPage < 1 {
+    firstPage = 1
+  }
+
+  return &Paginator[T]{firstPage: firstPage, fetch: fetch}
+}
+
+// All iterates over every item, fetching pages as needed. Iteration stops on the
+// first error (yielded with a zero item), or once the context is done.
+func (paginator *Paginator[T]) All(ctx context.Context) iter.Seq2[T, error] {
+  return func(yield func(T, error) bool) {
+    var zero T
+
+    for page := paginator.firstPage; ; page++ {
+      if err := ctx.Err(); err != nil {
+        yield(zero, err)
+        return
+      }
+
+      items, response, err := paginator.fetch(ctx, page)
+      if err != nil {
+        yield(zero, err)
+        return
+      }
+
+      paginator.readTotals(response)
+
+      for _, item := range items {
+        if !yield(item, nil) {
+          return
+        }
+      }
+
+      // Last page? (or endpoint is not paginated)
+      if len(items) == 0 || paginator.totalPages == 0 || page >= paginator.totalPages {
+        return
+      }
+    }
+  }
+}
+
+// Collect fetches every page and returns all items
+func (paginator *Paginator[T]) Collect(ctx context.Context) ([]T, error) {
+  all := []T{}
+
+  for item, err := range paginator.All(ctx) {
+    if err != nil {
+      return all, err
+    }
+
+    all = append(all, item)
+  }
+
+  return all, nil
+}
+
+// TotalItems returns the total number of items, known once the first page is fetched
+func (paginator *Paginator[T]) TotalItems() int {
+  return paginator.totalItems
+}
+
+// TotalPages returns the total number of pages, known once the first page is fetched
+func (paginator *Paginator[T]) TotalPages() int {
+  return paginator.totalPages
+}
+
+// readTotals reads pagination totals from the response headers
+func (paginator *Paginator[T]) readTotals(response *Response) {
+  if response == nil {
+    return
+  }
+
+  paginator.totalItems = response.TotalItems
+  paginator.totalPages = response.TotalPages
+}
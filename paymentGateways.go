@@ -0,0 +1,133 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// Payment gateways service
+type PaymentGatewaysService service
+
+// PaymentGateway object. Order is a number, or an empty string when the gateway was never sorted.
+// Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#payment-gateway-properties
+type PaymentGateway struct {
+  Id                 string                            `json:"id,omitempty"`
+  Title              string                            `json:"title,omitempty"`
+  Description        string                            `json:"description,omitempty"`
+  Order              interface{}                       `json:"order,omitempty"`
+  Enabled            bool                              `json:"enabled,omitempty"`
+  MethodTitle        string                            `json:"method_title,omitempty"`
+  MethodDescription  string                            `json:"method_description,omitempty"`
+  MethodSupports     []string                          `json:"method_supports,omitempty"`
+  Settings           map[string]PaymentGatewaySetting  `json:"settings,omitempty"`
+  Links              *Links                            `json:"_links,omitempty"`
+}
+
+// PaymentGatewaySetting object. Value and Default are usually strings, but multiselect settings
+// (eg. "enable_for_methods" of cash on delivery) hold a list of strings. Options map values to labels,
+// or group names to nested value/label maps.
+// Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#payment-gateway-settings-properties
+type PaymentGatewaySetting struct {
+  Id           string                  `json:"id,omitempty"`
+  Label        string                  `json:"label,omitempty"`
+  Description  string                  `json:"description,omitempty"`
+  Type         string                  `json:"type,omitempty"`
+  Value        interface{}             `json:"value,omitempty"`
+  Default      interface{}             `json:"default,omitempty"`
+  Tip          string                  `json:"tip,omitempty"`
+  Placeholder  string                  `json:"placeholder,omitempty"`
+  Options      map[string]interface{}  `json:"options,omitempty"`
+}
+
+// PaymentGatewayRequest is the body to update a payment gateway. Settings are sent as values
+// keyed by setting ID (eg. "instructions", or a []string for "enable_for_methods"), and Enabled is
+// only sent when set.
+type PaymentGatewayRequest struct {
+  Title        string                  `json:"title,omitempty"`
+  Description  string                  `json:"description,omitempty"`
+  Order        int                     `json:"order,omitempty"`
+  Enabled      *bool                   `json:"enabled,omitempty"`
+  Settings     map[string]interface{}  `json:"settings,omitempty"`
+}
+
+// Get a payment gateway. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-payment-gateway
+func (service *PaymentGatewaysService) Get(gatewayID string) (*PaymentGateway, *Response, error) {
+  return service.GetWithContext(context.Background(), gatewayID)
+}
+
+// Get a payment gateway with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-payment-gateway
+func (service *PaymentGatewaysService) GetWithContext(ctx context.Context, gatewayID string) (*PaymentGateway, *Response, error) {
+  _url := "/payment_gateways/" + gatewayID
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  gateway := new(PaymentGateway)
+  response, err := service.client.Do(req, gateway)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return gateway, response, nil
+}
+
+// List payment gateways. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-payment-gateways
+func (service *PaymentGatewaysService) List() (*[]PaymentGateway, *Response, error) {
+  return service.ListWithContext(context.Background())
+}
+
+// List payment gateways with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-payment-gateways
+func (service *PaymentGatewaysService) ListWithContext(ctx context.Context) (*[]PaymentGateway, *Response, error) {
+  _url := "/payment_gateways"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  gateways := new([]PaymentGateway)
+  response, err := service.client.Do(req, gateways)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return gateways, response, nil
+}
+
+// Update a payment gateway. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-payment-gateway
+func (service *PaymentGatewaysService) Update(gatewayID string, gateway *PaymentGatewayRequest) (*PaymentGateway, *Response, error) {
+  return service.UpdateWithContext(context.Background(), gatewayID, gateway)
+}
+
+// Update a payment gateway with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-payment-gateway
+func (service *PaymentGatewaysService) UpdateWithContext(ctx context.Context, gatewayID string, gateway *PaymentGatewayRequest) (*PaymentGateway, *Response, error) {
+  _url := "/payment_gateways/" + gatewayID
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, gateway)
+
+  updatedGateway := new(PaymentGateway)
+  response, err := service.client.Do(req, updatedGateway)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return updatedGateway, response, nil
+}
+
+// Enable a payment gateway
+func (service *PaymentGatewaysService) Enable(ctx context.Context, gatewayID string) (*PaymentGateway, error) {
+  return service.setEnabled(ctx, gatewayID, true)
+}
+
+// Disable a payment gateway
+func (service *PaymentGatewaysService) Disable(ctx context.Context, gatewayID string) (*PaymentGateway, error) {
+  return service.setEnabled(ctx, gatewayID, false)
+}
+
+// Update the settings of a payment gateway, keyed by setting ID. Other settings keep their current values.
+func (service *PaymentGatewaysService) UpdateSettings(ctx context.Context, gatewayID string, settings map[string]interface{}) (*PaymentGateway, error) {
+  gateway, _, err := service.UpdateWithContext(ctx, gatewayID, &PaymentGatewayRequest{Settings: settings})
+
+  return gateway, err
+}
+
+func (service *PaymentGatewaysService) setEnabled(ctx context.Context, gatewayID string, enabled bool) (*PaymentGateway, error) {
+  gateway, _, err := service.UpdateWithContext(ctx, gatewayID, &PaymentGatewayRequest{Enabled: &enabled})
+
+  return gateway, err
+}
\ No newline at end of file
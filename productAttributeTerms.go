@@ -0,0 +1,195 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// Product attribute terms service
+type ProductAttributeTermsService service
+
+// ProductAttributeTerm object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#product-attribute-term-properties
+type ProductAttributeTerm struct {
+  Id           int         `json:"id,omitempty"`
+  Name         string      `json:"name,omitempty"`
+  Slug         string      `json:"slug,omitempty"`
+  Description  string      `json:"description,omitempty"`
+  MenuOrder    int         `json:"menu_order,omitempty"`
+  Count        int         `json:"count,omitempty"`
+  Links        *Links      `json:"_links,omitempty"`
+}
+
+type ListProductAttributeTermParams struct {
+  Context    string    `url:"context,omitempty"`
+  Page       int       `url:"page,omitempty"`
+  PerPage    int       `url:"per_page,omitempty"`
+  Search     string    `url:"search,omitempty"`
+  Exclude    *[]int    `url:"exclude,omitempty"`
+  Include    *[]int    `url:"include,omitempty"`
+  Order      string    `url:"order,omitempty"`
+  OrderBy    string    `url:"orderby,omitempty"`
+  HideEmpty  bool      `url:"hide_empty,omitempty"`
+  Parent     *int      `url:"parent,omitempty"`
+  Product    int       `url:"product,omitempty"`
+  Slug       string    `url:"slug,omitempty"`
+}
+
+type DeleteProductAttributeTermParams struct {
+  Force    bool       `url:"force"`
+}
+
+type BatchProductAttributeTermUpdate struct {
+  Create  *[]ProductAttributeTerm `json:"create,omitempty"`
+  Update  *[]ProductAttributeTerm `json:"update,omitempty"`
+  Delete  *[]int                  `json:"delete,omitempty"`
+}
+
+type BatchProductAttributeTermUpdateResponse struct {
+  Create  *[]ProductAttributeTerm `json:"create,omitempty"`
+  Update  *[]ProductAttributeTerm `json:"update,omitempty"`
+  Delete  *[]ProductAttributeTerm `json:"delete,omitempty"`
+}
+
+// Create an attribute term. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-an-attribute-term
+func (service *ProductAttributeTermsService) Create(attributeID string, term *ProductAttributeTerm) (*ProductAttributeTerm, *Response, error) {
+  return service.CreateWithContext(context.Background(), attributeID, term)
+}
+
+// Create an attribute term with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-an-attribute-term
+func (service *ProductAttributeTermsService) CreateWithContext(ctx context.Context, attributeID string, term *ProductAttributeTerm) (*ProductAttributeTerm, *Response, error) {
+  _url := "/products/attributes/" + attributeID + "/terms"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, term)
+
+  createdTerm := new(ProductAttributeTerm)
+  response, err := service.client.Do(req, createdTerm)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return createdTerm, response, nil
+}
+
+// Get an attribute term. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-an-attribute-term
+func (service *ProductAttributeTermsService) Get(attributeID string, termID string) (*ProductAttributeTerm, *Response, error) {
+  return service.GetWithContext(context.Background(), attributeID, termID)
+}
+
+// Get an attribute term with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-an-attribute-term
+func (service *ProductAttributeTermsService) GetWithContext(ctx context.Context, attributeID string, termID string) (*ProductAttributeTerm, *Response, error) {
+  _url := "/products/attributes/" + attributeID + "/terms/" + termID
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  term := new(ProductAttributeTerm)
+  response, err := service.client.Do(req, term)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return term, response, nil
+}
+
+// List attribute terms. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-attribute-terms
+func (service *ProductAttributeTermsService) List(attributeID string, opts *ListProductAttributeTermParams) (*[]ProductAttributeTerm, *Response, error) {
+  return service.ListWithContext(context.Background(), attributeID, opts)
+}
+
+// List attribute terms with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-attribute-terms
+func (service *ProductAttributeTermsService) ListWithContext(ctx context.Context, attributeID string, opts *ListProductAttributeTermParams) (*[]ProductAttributeTerm, *Response, error) {
+  _url := "/products/attributes/" + attributeID + "/terms"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  terms := new([]ProductAttributeTerm)
+  response, err := service.client.Do(req, terms)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return terms, response, nil
+}
+
+// Update an attribute term. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-an-attribute-term
+func (service *ProductAttributeTermsService) Update(attributeID string, termID string, term *ProductAttributeTerm) (*ProductAttributeTerm, *Response, error) {
+  return service.UpdateWithContext(context.Background(), attributeID, termID, term)
+}
+
+// Update an attribute term with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-an-attribute-term
+func (service *ProductAttributeTermsService) UpdateWithContext(ctx context.Context, attributeID string, termID string, term *ProductAttributeTerm) (*ProductAttributeTerm, *Response, error) {
+  _url := "/products/attributes/" + attributeID + "/terms/" + termID
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, term)
+
+  updatedTerm := new(ProductAttributeTerm)
+  response, err := service.client.Do(req, updatedTerm)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return updatedTerm, response, nil
+}
+
+// Delete an attribute term. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-an-attribute-term
+func (service *ProductAttributeTermsService) Delete(attributeID string, termID string, opts *DeleteProductAttributeTermParams) (*ProductAttributeTerm, *Response, error) {
+  return service.DeleteWithContext(context.Background(), attributeID, termID, opts)
+}
+
+// Delete an attribute term with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-an-attribute-term
+func (service *ProductAttributeTermsService) DeleteWithContext(ctx context.Context, attributeID string, termID string, opts *DeleteProductAttributeTermParams) (*ProductAttributeTerm, *Response, error) {
+  _url := "/products/attributes/" + attributeID + "/terms/" + termID
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
+
+  term := new(ProductAttributeTerm)
+  response, err := service.client.Do(req, term)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return term, response, nil
+}
+
+// Batch update attribute terms. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-attribute-terms
+func (service *ProductAttributeTermsService) Batch(attributeID string, opts *BatchProductAttributeTermUpdate) (*BatchProductAttributeTermUpdateResponse, *Response, error) {
+  return service.BatchWithContext(context.Background(), attributeID, opts)
+}
+
+// Batch update attribute terms with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-attribute-terms
+func (service *ProductAttributeTermsService) BatchWithContext(ctx context.Context, attributeID string, opts *BatchProductAttributeTermUpdate) (*BatchProductAttributeTermUpdateResponse, *Response, error) {
+  _url := "/products/attributes/" + attributeID + "/terms/batch"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, opts)
+
+  terms := new(BatchProductAttributeTermUpdateResponse)
+  response, err := service.client.Do(req, terms)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return terms, response, nil
+}
+
+// Paginate attribute terms, fetching each page as it is iterated
+func (service *ProductAttributeTermsService) Paginate(attributeID string, opts *ListProductAttributeTermParams) *Paginator[ProductAttributeTerm] {
+  params := ListProductAttributeTermParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]ProductAttributeTerm, *Response, error) {
+    params.Page = page
+    productAttributeTerms, response, err := service.ListWithContext(ctx, attributeID, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *productAttributeTerms, response, nil
+  })
+}
+
+// List all attribute terms across every page
+func (service *ProductAttributeTermsService) ListAll(ctx context.Context, attributeID string, opts *ListProductAttributeTermParams) ([]ProductAttributeTerm, error) {
+  return service.Paginate(attributeID, opts).Collect(ctx)
+}
\ No newline at end of file
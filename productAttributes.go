@@ -0,0 +1,159 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// Product attributes service
+type ProductAttributesService service
+
+// ProductAttribute object, a global attribute. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#product-attribute-properties
+type ProductAttribute struct {
+  Id           int         `json:"id,omitempty"`
+  Name         string      `json:"name,omitempty"`
+  Slug         string      `json:"slug,omitempty"`
+  Type         string      `json:"type,omitempty"`
+  OrderBy      string      `json:"order_by,omitempty"`
+  HasArchives  bool        `json:"has_archives,omitempty"`
+  Links        *Links      `json:"_links,omitempty"`
+}
+
+type ListProductAttributeParams struct {
+  Context  string    `url:"context,omitempty"`
+}
+
+type DeleteProductAttributeParams struct {
+  Force    bool       `url:"force"`
+}
+
+type BatchProductAttributeUpdate struct {
+  Create  *[]ProductAttribute `json:"create,omitempty"`
+  Update  *[]ProductAttribute `json:"update,omitempty"`
+  Delete  *[]int              `json:"delete,omitempty"`
+}
+
+type BatchProductAttributeUpdateResponse struct {
+  Create  *[]ProductAttribute `json:"create,omitempty"`
+  Update  *[]ProductAttribute `json:"update,omitempty"`
+  Delete  *[]ProductAttribute `json:"delete,omitempty"`
+}
+
+// Create a product attribute. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-product-attribute
+func (service *ProductAttributesService) Create(attribute *ProductAttribute) (*ProductAttribute, *Response, error) {
+  return service.CreateWithContext(context.Background(), attribute)
+}
+
+// Create a product attribute with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-product-attribute
+func (service *ProductAttributesService) CreateWithContext(ctx context.Context, attribute *ProductAttribute) (*ProductAttribute, *Response, error) {
+  _url := "/products/attributes"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, attribute)
+
+  createdAttribute := new(ProductAttribute)
+  response, err := service.client.Do(req, createdAttribute)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return createdAttribute, response, nil
+}
+
+// Get a product attribute. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-product-attribute
+func (service *ProductAttributesService) Get(attributeID string) (*ProductAttribute, *Response, error) {
+  return service.GetWithContext(context.Background(), attributeID)
+}
+
+// Get a product attribute with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-product-attribute
+func (service *ProductAttributesService) GetWithContext(ctx context.Context, attributeID string) (*ProductAttribute, *Response, error) {
+  _url := "/products/attributes/" + attributeID
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  attribute := new(ProductAttribute)
+  response, err := service.client.Do(req, attribute)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return attribute, response, nil
+}
+
+// List product attributes. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-product-attributes
+func (service *ProductAttributesService) List(opts *ListProductAttributeParams) (*[]ProductAttribute, *Response, error) {
+  return service.ListWithContext(context.Background(), opts)
+}
+
+// List product attributes with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-product-attributes
+func (service *ProductAttributesService) ListWithContext(ctx context.Context, opts *ListProductAttributeParams) (*[]ProductAttribute, *Response, error) {
+  _url := "/products/attributes"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  attributes := new([]ProductAttribute)
+  response, err := service.client.Do(req, attributes)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return attributes, response, nil
+}
+
+// Update a product attribute. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-product-attribute
+func (service *ProductAttributesService) Update(attributeID string, attribute *ProductAttribute) (*ProductAttribute, *Response, error) {
+  return service.UpdateWithContext(context.Background(), attributeID, attribute)
+}
+
+// Update a product attribute with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-product-attribute
+func (service *ProductAttributesService) UpdateWithContext(ctx context.Context, attributeID string, attribute *ProductAttribute) (*ProductAttribute, *Response, error) {
+  _url := "/products/attributes/" + attributeID
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, attribute)
+
+  updatedAttribute := new(ProductAttribute)
+  response, err := service.client.Do(req, updatedAttribute)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return updatedAttribute, response, nil
+}
+
+// Delete a product attribute. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-product-attribute
+func (service *ProductAttributesService) Delete(attributeID string, opts *DeleteProductAttributeParams) (*ProductAttribute, *Response, error) {
+  return service.DeleteWithContext(context.Background(), attributeID, opts)
+}
+
+// Delete a product attribute with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-product-attribute
+func (service *ProductAttributesService) DeleteWithContext(ctx context.Context, attributeID string, opts *DeleteProductAttributeParams) (*ProductAttribute, *Response, error) {
+  _url := "/products/attributes/" + attributeID
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
+
+  attribute := new(ProductAttribute)
+  response, err := service.client.Do(req, attribute)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return attribute, response, nil
+}
+
+// Batch update product attributes. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-product-attributes
+func (service *ProductAttributesService) Batch(opts *BatchProductAttributeUpdate) (*BatchProductAttributeUpdateResponse, *Response, error) {
+  return service.BatchWithContext(context.Background(), opts)
+}
+
+// Batch update product attributes with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-product-attributes
+func (service *ProductAttributesService) BatchWithContext(ctx context.Context, opts *BatchProductAttributeUpdate) (*BatchProductAttributeUpdateResponse, *Response, error) {
+  _url := "/products/attributes/batch"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, opts)
+
+  attributes := new(BatchProductAttributeUpdateResponse)
+  response, err := service.client.Do(req, attributes)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return attributes, response, nil
+}
\ No newline at end of file
@@ -0,0 +1,184 @@
+package woocommerce
+
+import (
+  "context"
+  "strconv"
+  "strings"
+)
+
+// AttributeResolver maps product attribute names and options to global attributes
+// and their terms. Lookups are cached, it is not safe for concurrent use.
+type AttributeResolver struct {
+  // Create missing global attributes and terms when resolving
+  Create bool
+
+  attributes *ProductAttributesService
+  terms      *ProductAttributeTermsService
+
+  attributesCache []ProductAttribute
+  termsCache      map[int][]ProductAttributeTerm
+}
+
+// ResolvedAttribute is a product attribute mapped to a global attribute. AttributeID
+// is zero when no global attribute matches (ie. a custom product attribute). TermSlugs
+// is index aligned with Options, with an empty slug for each option without a term.
+type ResolvedAttribute struct {
+  AttributeID int
+  Name        string
+  Options     []string
+  TermSlugs   []string
+}
+
+// NewAttributeResolver creates a resolver using the client attribute services
+func NewAttributeResolver(client *Client, create bool) *AttributeResolver {
+  return &AttributeResolver{
+    Create:     create,
+    attributes: client.ProductAttributes,
+    terms:      client.ProductAttributeTerms,
+    termsCache: map[int][]ProductAttributeTerm{},
+  }
+}
+
+// Resolve maps each product attribute to its global attribute ID and term slugs
+func (resolver *AttributeResolver) Resolve(ctx context.Context, attributes []ProductAttributes) ([]ResolvedAttribute, error) {
+  resolved := make([]ResolvedAttribute, 0, len(attributes))
+
+  for _, attribute := range attributes {
+    resolvedAttribute := ResolvedAttribute{Name: attribute.Name, Options: attribute.Options}
+
+    globalAttribute, err := resolver.ResolveAttribute(ctx, attribute.Name)
+    if err != nil {
+      return nil, err
+    }
+
+    // Custom product attribute? (no global attribute)
+    if globalAttribute == nil {
+      resolvedAttribute.TermSlugs = make([]string, len(attribute.Options))
+      resolved = append(resolved, resolvedAttribute)
+
+      continue
+    }
+
+    resolvedAttribute.AttributeID = globalAttribute.Id
+    resolvedAttribute.Name = globalAttribute.Name
+    resolvedAttribute.Options = []string{}
+    resolvedAttribute.TermSlugs = []string{}
+
+    for _, option := range attribute.Options {
+      term, err := resolver.ResolveTerm(ctx, globalAttribute.Id, option)
+      if err != nil {
+        return nil, err
+      }
+
+      // Keep unknown option as is? (term not created)
+      if term == nil {
+        resolvedAttribute.Options = append(resolvedAttribute.Options, option)
+        resolvedAttribute.TermSlugs = append(resolvedAttribute.TermSlugs, "")
+
+        continue
+      }
+
+      resolvedAttribute.Options = append(resolvedAttribute.Options, term.Name)
+      resolvedAttribute.TermSlugs = append(resolvedAttribute.TermSlugs, term.Slug)
+    }
+
+    resolved = append(resolved, resolvedAttribute)
+  }
+
+  return resolved, nil
+}
+
+// ResolveAttribute returns the global attribute with the given name or slug. Returns
+// nil when it does not exist and the resolver does not create attributes.
+func (resolver *AttributeResolver) ResolveAttribute(ctx context.Context, name string) (*ProductAttribute, error) {
+  if resolver.attributesCache == nil {
+    attributes, _, err := resolver.attributes.ListWithContext(ctx, nil)
+    if err != nil {
+      return nil, err
+    }
+
+    resolver.attributesCache = *attributes
+  }
+
+  attribute := findTerm(resolver.attributesCache, func(attribute ProductAttribute) bool {
+    return matchTermName(attribute.Name, name) || strings.EqualFold(attribute.Slug, name) || strings.EqualFold(attribute.Slug, "pa_"+name)
+  })
+
+  if attribute != nil || !resolver.Create {
+    return attribute, nil
+  }
+
+  createdAttribute, _, err := resolver.attributes.CreateWithContext(ctx, &ProductAttribute{Name: name})
+  if err != nil {
+    return nil, err
+  }
+
+  resolver.attributesCache = append(resolver.attributesCache, *createdAttribute)
+
+  return createdAttribute, nil
+}
+
+// ResolveTerm returns the term of the global attribute with the given name or slug. Returns
+// nil when it does not exist and the resolver does not create terms.
+func (resolver *AttributeResolver) ResolveTerm(ctx context.Context, attributeID int, name string) (*ProductAttributeTerm, error) {
+  attributeIDStr := strconv.Itoa(attributeID)
+
+  terms, ok := resolver.termsCache[attributeID]
+  if !ok {
+    allTerms, err := resolver.terms.ListAll(ctx, attributeIDStr, &ListProductAttributeTermParams{PerPage: 100})
+    if err != nil {
+      return nil, err
+    }
+
+    terms = allTerms
+    resolver.termsCache[attributeID] = terms
+  }
+
+  term := findTerm(terms, func(term ProductAttributeTerm) bool {
+    return matchTermName(term.Name, name) || strings.EqualFold(term.Slug, name)
+  })
+
+  if term != nil || !resolver.Create {
+    return term, nil
+  }
+
+  createdTerm, err := ensureTerm(ctx,
+    func(ctx context.Context) (*ProductAttributeTerm, error) {
+      return nil, nil
+    },
+    func(ctx context.Context) (*ProductAttributeTerm, error) {
+      created, _, err := resolver.terms.CreateWithContext(ctx, attributeIDStr, &ProductAttributeTerm{Name: name})
+      return created, err
+    },
+    func(ctx context.Context, termID string) (*ProductAttributeTerm, error) {
+      existing, _, err := resolver.terms.GetWithContext(ctx, attributeIDStr, termID)
+      return existing, err
+    },
+  )
+
+  if err != nil {
+    return nil, err
+  }
+
+  resolver.termsCache[attributeID] = append(terms, *createdTerm)
+
+  return createdTerm, nil
+}
+
+// ProductAttributesFromResolved converts resolved attributes to product attributes, ready to be set on a product
+func ProductAttributesFromResolved(resolved []ResolvedAttribute, visible bool, variation bool) []ProductAttributes {
+  attributes := make([]ProductAttributes, 0, len(resolved))
+
+  for position, attribute := range resolved {
+    attributes = append(attributes, ProductAttributes{
+      Id:        attribute.AttributeID,
+      Name:      attribute.Name,
+      Position:  position,
+      Visible:   visible,
+      Variation: variation,
+      Options:   attribute.Options,
+    })
+  }
+
+  return attributes
+}
@@ -0,0 +1,198 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// Product categories service
+type ProductCategoriesService service
+
+// ProductCategory object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#product-category-properties
+type ProductCategory struct {
+  Id                 int         `json:"id,omitempty"`
+  Name               string      `json:"name,omitempty"`
+  Slug               string      `json:"slug,omitempty"`
+  Parent             int         `json:"parent,omitempty"`
+  Description        string      `json:"description,omitempty"`
+  Display            string      `json:"display,omitempty"`
+  Image              *Image      `json:"image,omitempty"`
+  MenuOrder          int         `json:"menu_order,omitempty"`
+  Count              int         `json:"count,omitempty"`
+  Links              *Links      `json:"_links,omitempty"`
+}
+
+type ListProductCategoryParams struct {
+  Context    string    `url:"context,omitempty"`
+  Page       int       `url:"page,omitempty"`
+  PerPage    int       `url:"per_page,omitempty"`
+  Search     string    `url:"search,omitempty"`
+  Exclude    *[]int    `url:"exclude,omitempty"`
+  Include    *[]int    `url:"include,omitempty"`
+  Order      string    `url:"order,omitempty"`
+  OrderBy    string    `url:"orderby,omitempty"`
+  HideEmpty  bool      `url:"hide_empty,omitempty"`
+  Parent     *int      `url:"parent,omitempty"`
+  Product    int       `url:"product,omitempty"`
+  Slug       string    `url:"slug,omitempty"`
+}
+
+type DeleteProductCategoryParams struct {
+  Force    bool       `url:"force"`
+}
+
+type BatchProductCategoryUpdate struct {
+  Create  *[]ProductCategory `json:"create,omitempty"`
+  Update  *[]ProductCategory `json:"update,omitempty"`
+  Delete  *[]int             `json:"delete,omitempty"`
+}
+
+type BatchProductCategoryUpdateResponse struct {
+  Create  *[]ProductCategory `json:"create,omitempty"`
+  Update  *[]ProductCategory `json:"update,omitempty"`
+  Delete  *[]ProductCategory `json:"delete,omitempty"`
+}
+
+// Create a product category. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-product-category
+func (service *ProductCategoriesService) Create(category *ProductCategory) (*ProductCategory, *Response, error) {
+  return service.CreateWithContext(context.Background(), category)
+}
+
+// Create a product category with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-product-category
+func (service *ProductCategoriesService) CreateWithContext(ctx context.Context, category *ProductCategory) (*ProductCategory, *Response, error) {
+  _url := "/products/categories"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, category)
+
+  createdCategory := new(ProductCategory)
+  response, err := service.client.Do(req, createdCategory)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return createdCategory, response, nil
+}
+
+// Get a product category. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-product-category
+func (service *ProductCategoriesService) Get(categoryID string) (*ProductCategory, *Response, error) {
+  return service.GetWithContext(context.Background(), categoryID)
+}
+
+// Get a product category with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-product-category
+func (service *ProductCategoriesService) GetWithContext(ctx context.Context, categoryID string) (*ProductCategory, *Response, error) {
+  _url := "/products/categories/" + categoryID
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  category := new(ProductCategory)
+  response, err := service.client.Do(req, category)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return category, response, nil
+}
+
+// List product categories. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-product-categories
+func (service *ProductCategoriesService) List(opts *ListProductCategoryParams) (*[]ProductCategory, *Response, error) {
+  return service.ListWithContext(context.Background(), opts)
+}
+
+// List product categories with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-product-categories
+func (service *ProductCategoriesService) ListWithContext(ctx context.Context, opts *ListProductCategoryParams) (*[]ProductCategory, *Response, error) {
+  _url := "/products/categories"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  categories := new([]ProductCategory)
+  response, err := service.client.Do(req, categories)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return categories, response, nil
+}
+
+// Update a product category. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-product-category
+func (service *ProductCategoriesService) Update(categoryID string, category *ProductCategory) (*ProductCategory, *Response, error) {
+  return service.UpdateWithContext(context.Background(), categoryID, category)
+}
+
+// Update a product category with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-product-category
+func (service *ProductCategoriesService) UpdateWithContext(ctx context.Context, categoryID string, category *ProductCategory) (*ProductCategory, *Response, error) {
+  _url := "/products/categories/" + categoryID
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, category)
+
+  updatedCategory := new(ProductCategory)
+  response, err := service.client.Do(req, updatedCategory)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return updatedCategory, response, nil
+}
+
+// Delete a product category. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-product-category
+func (service *ProductCategoriesService) Delete(categoryID string, opts *DeleteProductCategoryParams) (*ProductCategory, *Response, error) {
+  return service.DeleteWithContext(context.Background(), categoryID, opts)
+}
+
+// Delete a product category with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-product-category
+func (service *ProductCategoriesService) DeleteWithContext(ctx context.Context, categoryID string, opts *DeleteProductCategoryParams) (*ProductCategory, *Response, error) {
+  _url := "/products/categories/" + categoryID
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
+
+  category := new(ProductCategory)
+  response, err := service.client.Do(req, category)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return category, response, nil
+}
+
+// Batch update product categories. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-product-categories
+func (service *ProductCategoriesService) Batch(opts *BatchProductCategoryUpdate) (*BatchProductCategoryUpdateResponse, *Response, error) {
+  return service.BatchWithContext(context.Background(), opts)
+}
+
+// Batch update product categories with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-product-categories
+func (service *ProductCategoriesService) BatchWithContext(ctx context.Context, opts *BatchProductCategoryUpdate) (*BatchProductCategoryUpdateResponse, *Response, error) {
+  _url := "/products/categories/batch"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, opts)
+
+  categories := new(BatchProductCategoryUpdateResponse)
+  response, err := service.client.Do(req, categories)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return categories, response, nil
+}
+
+// Paginate product categories, fetching each page as it is iterated
+func (service *ProductCategoriesService) Paginate(opts *ListProductCategoryParams) *Paginator[ProductCategory] {
+  params := ListProductCategoryParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]ProductCategory, *Response, error) {
+    params.Page = page
+    productCategories, response, err := service.ListWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *productCategories, response, nil
+  })
+}
+
+// List all product categories across every page
+func (service *ProductCategoriesService) ListAll(ctx context.Context, opts *ListProductCategoryParams) ([]ProductCategory, error) {
+  return service.Paginate(opts).Collect(ctx)
+}
\ No newline at end of file
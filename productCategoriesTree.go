@@ -0,0 +1,224 @@
+package woocommerce
+
+import (
+  "context"
+  "errors"
+  "html"
+  "strconv"
+  "strings"
+)
+
+// CategoryPathSeparator separates category names in a tree path (eg. "Clothing/Shirts/Polo")
+const CategoryPathSeparator = "/"
+
+var errorCategoryPathEmpty = errors.New("category path is empty")
+
+// CategoryTree is an in-memory tree of product categories. It is not safe for concurrent use.
+type CategoryTree struct {
+  Roots []*CategoryNode
+
+  nodes   map[int]*CategoryNode
+  service *ProductCategoriesService
+}
+
+// CategoryNode is a category in a tree, with its parent and children
+type CategoryNode struct {
+  Category ProductCategory
+  Parent   *CategoryNode
+  Children []*CategoryNode
+}
+
+// Path returns the names of the category and its ancestors, joined by CategoryPathSeparator
+func (node *CategoryNode) Path() string {
+  names := []string{}
+
+  for current := node; current != nil; current = current.Parent {
+    names = append([]string{html.UnescapeString(current.Category.Name)}, names...)
+  }
+
+  return strings.Join(names, CategoryPathSeparator)
+}
+
+// child returns the child category with the given name (case-insensitive)
+func (node *CategoryNode) child(name string) *CategoryNode {
+  return findCategoryNode(node.Children, name)
+}
+
+// Tree fetches all product categories, and builds them into a tree
+func (service *ProductCategoriesService) Tree(ctx context.Context) (*CategoryTree, error) {
+  categories, err := service.ListAll(ctx, &ListProductCategoryParams{PerPage: 100})
+  if err != nil {
+    return nil, err
+  }
+
+  return NewCategoryTree(service, categories), nil
+}
+
+// NewCategoryTree builds a tree from the given categories. The service is used to create missing categories.
+func NewCategoryTree(service *ProductCategoriesService, categories []ProductCategory) *CategoryTree {
+  tree := &CategoryTree{nodes: map[int]*CategoryNode{}, service: service}
+
+  for _, category := range categories {
+    tree.nodes[category.Id] = &CategoryNode{Category: category}
+  }
+
+  for _, category := range categories {
+    tree.attach(tree.nodes[category.Id])
+  }
+
+  return tree
+}
+
+// Get returns the category node with the given ID
+func (tree *CategoryTree) Get(categoryID int) (*CategoryNode, bool) {
+  node, ok := tree.nodes[categoryID]
+
+  return node, ok
+}
+
+// Lookup returns the category node at the given path (eg. "Clothing/Shirts/Polo").
+// Names are matched case-insensitively.
+func (tree *CategoryTree) Lookup(path string) (*CategoryNode, bool) {
+  var node *CategoryNode
+
+  for i, name := range splitCategoryPath(path) {
+    if i == 0 {
+      node = findCategoryNode(tree.Roots, name)
+    } else {
+      node = node.child(name)
+    }
+
+    if node == nil {
+      return nil, false
+    }
+  }
+
+  return node, node != nil
+}
+
+// EnsurePath returns the category node at the given path, creating any missing categories
+func (tree *CategoryTree) EnsurePath(ctx context.Context, path string) (*CategoryNode, error) {
+  var node *CategoryNode
+
+  names := splitCategoryPath(path)
+  if len(names) == 0 {
+    return nil, errorCategoryPathEmpty
+  }
+
+  for i, name := range names {
+    var next *CategoryNode
+
+    if i == 0 {
+      next = findCategoryNode(tree.Roots, name)
+    } else {
+      next = node.child(name)
+    }
+
+    // Create missing category? (under the current node)
+    if next == nil {
+      category := &ProductCategory{Name: name}
+
+      if node != nil {
+        category.Parent = node.Category.Id
+      }
+
+      createdCategory, err := ensureTerm(ctx,
+        func(ctx context.Context) (*ProductCategory, error) {
+          // Already looked up in the tree
+          return nil, nil
+        },
+        func(ctx context.Context) (*ProductCategory, error) {
+          created, _, err := tree.service.CreateWithContext(ctx, category)
+          return created, err
+        },
+        func(ctx context.Context, categoryID string) (*ProductCategory, error) {
+          existing, _, err := tree.service.GetWithContext(ctx, categoryID)
+          return existing, err
+        },
+      )
+
+      if err != nil {
+        return nil, err
+      }
+
+      // Category created concurrently, but already in the tree? (eg. under another name)
+      if existing, ok := tree.nodes[createdCategory.Id]; ok {
+        next = existing
+      } else {
+        next = &CategoryNode{Category: *createdCategory}
+        tree.nodes[createdCategory.Id] = next
+        tree.attach(next)
+      }
+    }
+
+    node = next
+  }
+
+  return node, nil
+}
+
+// attach links the node to its parent, or to the roots
+func (tree *CategoryTree) attach(node *CategoryNode) {
+  parent, ok := tree.nodes[node.Category.Parent]
+
+  if node.Category.Parent == 0 || !ok {
+    tree.Roots = append(tree.Roots, node)
+
+    return
+  }
+
+  node.Parent = parent
+  parent.Children = append(parent.Children, node)
+}
+
+// Paths returns the ID of every category, keyed by its path
+func (tree *CategoryTree) Paths() map[string]int {
+  paths := make(map[string]int, len(tree.nodes))
+
+  for categoryID, node := range tree.nodes {
+    paths[node.Path()] = categoryID
+  }
+
+  return paths
+}
+
+// String returns the category tree, one path per line
+func (tree *CategoryTree) String() string {
+  var builder strings.Builder
+
+  var walk func(nodes []*CategoryNode)
+  walk = func(nodes []*CategoryNode) {
+    for _, node := range nodes {
+      builder.WriteString(node.Path() + " (" + strconv.Itoa(node.Category.Id) + ")\n")
+      walk(node.Children)
+    }
+  }
+
+  walk(tree.Roots)
+
+  return builder.String()
+}
+
+// findCategoryNode returns the node with the given name (see matchTermName)
+func findCategoryNode(nodes []*CategoryNode, name string) *CategoryNode {
+  for _, node := range nodes {
+    if matchTermName(node.Category.Name, name) {
+      return node
+    }
+  }
+
+  return nil
+}
+
+// splitCategoryPath splits a path into trimmed, non-empty category names
+func splitCategoryPath(path string) []string {
+  names := []string{}
+
+  for _, name := range strings.Split(path, CategoryPathSeparator) {
+    if name = strings.TrimSpace(name); name != "" {
+      names = append(names, name)
+    }
+  }
+
+  return names
+}
@@ -0,0 +1,281 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// Product reviews service
+type ProductReviewsService service
+
+// Product review statuses
+const (
+  ReviewStatusApproved = "approved"
+  ReviewStatusHold     = "hold"
+  ReviewStatusSpam     = "spam"
+  ReviewStatusUnspam   = "unspam"
+  ReviewStatusTrash    = "trash"
+  ReviewStatusUntrash  = "untrash"
+)
+
+// batchUpdateLimit is the maximum number of objects the API accepts in a batch request
+const batchUpdateLimit = 100
+
+// ProductReview object. Error is only set on objects of a batch response that failed.
+// Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#product-review-properties
+type ProductReview struct {
+  Id                  int                `json:"id,omitempty"`
+  DateCreated         string             `json:"date_created,omitempty"`
+  DateCreatedGmt      string             `json:"date_created_gmt,omitempty"`
+  ProductId           int                `json:"product_id,omitempty"`
+  ProductName         string             `json:"product_name,omitempty"`
+  ProductPermalink    string             `json:"product_permalink,omitempty"`
+  Status              string             `json:"status,omitempty"`
+  Reviewer            string             `json:"reviewer,omitempty"`
+  ReviewerEmail       string             `json:"reviewer_email,omitempty"`
+  Review              string             `json:"review,omitempty"`
+  Rating              int                `json:"rating,omitempty"`
+  Verified            bool               `json:"verified,omitempty"`
+  ReviewerAvatarUrls  map[string]string  `json:"reviewer_avatar_urls,omitempty"`
+  Links               *Links             `json:"_links,omitempty"`
+  Error               *BatchItemError    `json:"error,omitempty"`
+}
+
+type ListProductReviewParams struct {
+  Context          string    `url:"context,omitempty"`
+  Page             int       `url:"page,omitempty"`
+  PerPage          int       `url:"per_page,omitempty"`
+  Search           string    `url:"search,omitempty"`
+  After            string    `url:"after,omitempty"`
+  Before           string    `url:"before,omitempty"`
+  Exclude          *[]int    `url:"exclude,omitempty"`
+  Include          *[]int    `url:"include,omitempty"`
+  Offset           int       `url:"offset,omitempty"`
+  Order            string    `url:"order,omitempty"`
+  OrderBy          string    `url:"orderby,omitempty"`
+  Reviewer         *[]int    `url:"reviewer,omitempty"`
+  ReviewerExclude  *[]int    `url:"reviewer_exclude,omitempty"`
+  ReviewerEmail    string    `url:"reviewer_email,omitempty"`
+  Product          *[]int    `url:"product,omitempty"`
+  Status           string    `url:"status,omitempty"`
+}
+
+type DeleteProductReviewParams struct {
+  Force    bool       `url:"force"`
+}
+
+type BatchProductReviewUpdate struct {
+  Create  *[]ProductReview `json:"create,omitempty"`
+  Update  *[]ProductReview `json:"update,omitempty"`
+  Delete  *[]int           `json:"delete,omitempty"`
+}
+
+type BatchProductReviewUpdateResponse struct {
+  Create  *[]ProductReview `json:"create,omitempty"`
+  Update  *[]ProductReview `json:"update,omitempty"`
+  Delete  *[]ProductReview `json:"delete,omitempty"`
+}
+
+// Create a product review. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-product-review
+func (service *ProductReviewsService) Create(review *ProductReview) (*ProductReview, *Response, error) {
+  return service.CreateWithContext(context.Background(), review)
+}
+
+// Create a product review with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-product-review
+func (service *ProductReviewsService) CreateWithContext(ctx context.Context, review *ProductReview) (*ProductReview, *Response, error) {
+  _url := "/products/reviews"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, review)
+
+  createdReview := new(ProductReview)
+  response, err := service.client.Do(req, createdReview)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return createdReview, response, nil
+}
+
+// Get a product review. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-product-review
+func (service *ProductReviewsService) Get(reviewID string) (*ProductReview, *Response, error) {
+  return service.GetWithContext(context.Background(), reviewID)
+}
+
+// Get a product review with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-product-review
+func (service *ProductReviewsService) GetWithContext(ctx context.Context, reviewID string) (*ProductReview, *Response, error) {
+  _url := "/products/reviews/" + reviewID
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  review := new(ProductReview)
+  response, err := service.client.Do(req, review)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return review, response, nil
+}
+
+// List product reviews. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-product-reviews
+func (service *ProductReviewsService) List(opts *ListProductReviewParams) (*[]ProductReview, *Response, error) {
+  return service.ListWithContext(context.Background(), opts)
+}
+
+// List product reviews with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-product-reviews
+func (service *ProductReviewsService) ListWithContext(ctx context.Context, opts *ListProductReviewParams) (*[]ProductReview, *Response, error) {
+  _url := "/products/reviews"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  reviews := new([]ProductReview)
+  response, err := service.client.Do(req, reviews)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return reviews, response, nil
+}
+
+// Update a product review. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-product-review
+func (service *ProductReviewsService) Update(reviewID string, review *ProductReview) (*ProductReview, *Response, error) {
+  return service.UpdateWithContext(context.Background(), reviewID, review)
+}
+
+// Update a product review with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-product-review
+func (service *ProductReviewsService) UpdateWithContext(ctx context.Context, reviewID string, review *ProductReview) (*ProductReview, *Response, error) {
+  _url := "/products/reviews/" + reviewID
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, review)
+
+  updatedReview := new(ProductReview)
+  response, err := service.client.Do(req, updatedReview)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return updatedReview, response, nil
+}
+
+// Delete a product review. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-product-review
+func (service *ProductReviewsService) Delete(reviewID string, opts *DeleteProductReviewParams) (*ProductReview, *Response, error) {
+  return service.DeleteWithContext(context.Background(), reviewID, opts)
+}
+
+// Delete a product review with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-product-review
+func (service *ProductReviewsService) DeleteWithContext(ctx context.Context, reviewID string, opts *DeleteProductReviewParams) (*ProductReview, *Response, error) {
+  _url := "/products/reviews/" + reviewID
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
+
+  review := new(ProductReview)
+  response, err := service.client.Do(req, review)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return review, response, nil
+}
+
+// Batch update product reviews. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-product-reviews
+func (service *ProductReviewsService) Batch(opts *BatchProductReviewUpdate) (*BatchProductReviewUpdateResponse, *Response, error) {
+  return service.BatchWithContext(context.Background(), opts)
+}
+
+// Batch update product reviews with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-product-reviews
+func (service *ProductReviewsService) BatchWithContext(ctx context.Context, opts *BatchProductReviewUpdate) (*BatchProductReviewUpdateResponse, *Response, error) {
+  _url := "/products/reviews/batch"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, opts)
+
+  reviews := new(BatchProductReviewUpdateResponse)
+  response, err := service.client.Do(req, reviews)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return reviews, response, nil
+}
+
+// Paginate product reviews, fetching each page as it is iterated
+func (service *ProductReviewsService) Paginate(opts *ListProductReviewParams) *Paginator[ProductReview] {
+  params := ListProductReviewParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]ProductReview, *Response, error) {
+    params.Page = page
+    productReviews, response, err := service.ListWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *productReviews, response, nil
+  })
+}
+
+// List all product reviews across every page
+func (service *ProductReviewsService) ListAll(ctx context.Context, opts *ListProductReviewParams) ([]ProductReview, error) {
+  return service.Paginate(opts).Collect(ctx)
+}
+
+// Approve product reviews, in batches
+func (service *ProductReviewsService) Approve(ctx context.Context, reviewIDs ...int) ([]ProductReview, error) {
+  return service.SetStatus(ctx, ReviewStatusApproved, reviewIDs...)
+}
+
+// Hold product reviews for moderation, in batches
+func (service *ProductReviewsService) Hold(ctx context.Context, reviewIDs ...int) ([]ProductReview, error) {
+  return service.SetStatus(ctx, ReviewStatusHold, reviewIDs...)
+}
+
+// Mark product reviews as spam, in batches
+func (service *ProductReviewsService) Spam(ctx context.Context, reviewIDs ...int) ([]ProductReview, error) {
+  return service.SetStatus(ctx, ReviewStatusSpam, reviewIDs...)
+}
+
+// Move product reviews to the trash, in batches
+func (service *ProductReviewsService) Trash(ctx context.Context, reviewIDs ...int) ([]ProductReview, error) {
+  return service.SetStatus(ctx, ReviewStatusTrash, reviewIDs...)
+}
+
+// Set the status of product reviews, sending batches of up to 100 reviews. Reviews the API rejects
+// (eg. an unknown ID) are left out of the updated reviews, and returned in a *BatchError.
+func (service *ProductReviewsService) SetStatus(ctx context.Context, status string, reviewIDs ...int) ([]ProductReview, error) {
+  updatedReviews := []ProductReview{}
+  failures := []BatchFailure{}
+
+  for start := 0; start < len(reviewIDs); start += batchUpdateLimit {
+    end := min(start+batchUpdateLimit, len(reviewIDs))
+
+    reviews := make([]ProductReview, 0, end-start)
+
+    for _, reviewID := range reviewIDs[start:end] {
+      reviews = append(reviews, ProductReview{Id: reviewID, Status: status})
+    }
+
+    batch, _, err := service.BatchWithContext(ctx, &BatchProductReviewUpdate{Update: &reviews})
+    if err != nil {
+      return updatedReviews, err
+    }
+
+    if batch.Update == nil {
+      continue
+    }
+
+    for i, review := range *batch.Update {
+      if review.Error != nil {
+        failures = append(failures, BatchFailure{Index: start + i, Id: review.Id, Err: review.Error})
+      } else {
+        updatedReviews = append(updatedReviews, review)
+      }
+    }
+  }
+
+  if len(failures) > 0 {
+    return updatedReviews, &BatchError{Failures: failures}
+  }
+
+  return updatedReviews, nil
+}
\ No newline at end of file
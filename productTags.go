@@ -0,0 +1,235 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// Product tags service
+type ProductTagsService service
+
+// ProductTag object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#product-tag-properties
+type ProductTag struct {
+  Id                 int         `json:"id,omitempty"`
+  Name               string      `json:"name,omitempty"`
+  Slug               string      `json:"slug,omitempty"`
+  Description        string      `json:"description,omitempty"`
+  Count              int         `json:"count,omitempty"`
+  Links              *Links      `json:"_links,omitempty"`
+}
+
+type ListProductTagParams struct {
+  Context    string    `url:"context,omitempty"`
+  Page       int       `url:"page,omitempty"`
+  PerPage    int       `url:"per_page,omitempty"`
+  Search     string    `url:"search,omitempty"`
+  Exclude    *[]int    `url:"exclude,omitempty"`
+  Include    *[]int    `url:"include,omitempty"`
+  Offset     int       `url:"offset,omitempty"`
+  Order      string    `url:"order,omitempty"`
+  OrderBy    string    `url:"orderby,omitempty"`
+  HideEmpty  bool      `url:"hide_empty,omitempty"`
+  Product    int       `url:"product,omitempty"`
+  Slug       string    `url:"slug,omitempty"`
+}
+
+type DeleteProductTagParams struct {
+  Force    bool       `url:"force"`
+}
+
+type BatchProductTagUpdate struct {
+  Create  *[]ProductTag `json:"create,omitempty"`
+  Update  *[]ProductTag `json:"update,omitempty"`
+  Delete  *[]int        `json:"delete,omitempty"`
+}
+
+type BatchProductTagUpdateResponse struct {
+  Create  *[]ProductTag `json:"create,omitempty"`
+  Update  *[]ProductTag `json:"update,omitempty"`
+  Delete  *[]ProductTag `json:"delete,omitempty"`
+}
+
+// Create a product tag. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-product-tag
+func (service *ProductTagsService) Create(tag *ProductTag) (*ProductTag, *Response, error) {
+  return service.CreateWithContext(context.Background(), tag)
+}
+
+// Create a product tag with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-product-tag
+func (service *ProductTagsService) CreateWithContext(ctx context.Context, tag *ProductTag) (*ProductTag, *Response, error) {
+  _url := "/products/tags"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, tag)
+
+  createdTag := new(ProductTag)
+  response, err := service.client.Do(req, createdTag)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return createdTag, response, nil
+}
+
+// Get a product tag. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-product-tag
+func (service *ProductTagsService) Get(tagID string) (*ProductTag, *Response, error) {
+  return service.GetWithContext(context.Background(), tagID)
+}
+
+// Get a product tag with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-product-tag
+func (service *ProductTagsService) GetWithContext(ctx context.Context, tagID string) (*ProductTag, *Response, error) {
+  _url := "/products/tags/" + tagID
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  tag := new(ProductTag)
+  response, err := service.client.Do(req, tag)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return tag, response, nil
+}
+
+// List product tags. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-product-tags
+func (service *ProductTagsService) List(opts *ListProductTagParams) (*[]ProductTag, *Response, error) {
+  return service.ListWithContext(context.Background(), opts)
+}
+
+// List product tags with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-product-tags
+func (service *ProductTagsService) ListWithContext(ctx context.Context, opts *ListProductTagParams) (*[]ProductTag, *Response, error) {
+  _url := "/products/tags"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  tags := new([]ProductTag)
+  response, err := service.client.Do(req, tags)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return tags, response, nil
+}
+
+// Update a product tag. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-product-tag
+func (service *ProductTagsService) Update(tagID string, tag *ProductTag) (*ProductTag, *Response, error) {
+  return service.UpdateWithContext(context.Background(), tagID, tag)
+}
+
+// Update a product tag with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-product-tag
+func (service *ProductTagsService) UpdateWithContext(ctx context.Context, tagID string, tag *ProductTag) (*ProductTag, *Response, error) {
+  _url := "/products/tags/" + tagID
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, tag)
+
+  updatedTag := new(ProductTag)
+  response, err := service.client.Do(req, updatedTag)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return updatedTag, response, nil
+}
+
+// Delete a product tag. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-product-tag
+func (service *ProductTagsService) Delete(tagID string, opts *DeleteProductTagParams) (*ProductTag, *Response, error) {
+  return service.DeleteWithContext(context.Background(), tagID, opts)
+}
+
+// Delete a product tag with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-product-tag
+func (service *ProductTagsService) DeleteWithContext(ctx context.Context, tagID string, opts *DeleteProductTagParams) (*ProductTag, *Response, error) {
+  _url := "/products/tags/" + tagID
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
+
+  tag := new(ProductTag)
+  response, err := service.client.Do(req, tag)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return tag, response, nil
+}
+
+// Batch update product tags. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-product-tags
+func (service *ProductTagsService) Batch(opts *BatchProductTagUpdate) (*BatchProductTagUpdateResponse, *Response, error) {
+  return service.BatchWithContext(context.Background(), opts)
+}
+
+// Batch update product tags with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-product-tags
+func (service *ProductTagsService) BatchWithContext(ctx context.Context, opts *BatchProductTagUpdate) (*BatchProductTagUpdateResponse, *Response, error) {
+  _url := "/products/tags/batch"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, opts)
+
+  tags := new(BatchProductTagUpdateResponse)
+  response, err := service.client.Do(req, tags)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return tags, response, nil
+}
+
+// Paginate product tags, fetching each page as it is iterated
+func (service *ProductTagsService) Paginate(opts *ListProductTagParams) *Paginator[ProductTag] {
+  params := ListProductTagParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]ProductTag, *Response, error) {
+    params.Page = page
+    productTags, response, err := service.ListWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *productTags, response, nil
+  })
+}
+
+// List all product tags across every page
+func (service *ProductTagsService) ListAll(ctx context.Context, opts *ListProductTagParams) ([]ProductTag, error) {
+  return service.Paginate(opts).Collect(ctx)
+}
+
+// Find a product tag by name (case-insensitive). Returns nil when no product tag matches.
+func (service *ProductTagsService) FindByName(ctx context.Context, name string) (*ProductTag, error) {
+  tags, err := service.ListAll(ctx, &ListProductTagParams{Search: name, PerPage: 100})
+  if err != nil {
+    return nil, err
+  }
+
+  return findTerm(tags, func(term ProductTag) bool {
+    return matchTermName(term.Name, name)
+  }), nil
+}
+
+// Find a product tag by slug. Returns nil when no product tag matches.
+func (service *ProductTagsService) FindBySlug(ctx context.Context, slug string) (*ProductTag, error) {
+  tags, _, err := service.ListWithContext(ctx, &ListProductTagParams{Slug: slug})
+  if err != nil {
+    return nil, err
+  }
+
+  return findTerm(*tags, func(term ProductTag) bool {
+    return term.Slug == slug
+  }), nil
+}
+
+// Ensure a product tag exists, finding it by name or creating it
+func (service *ProductTagsService) Ensure(ctx context.Context, name string) (*ProductTag, error) {
+  return ensureTerm(ctx,
+    func(ctx context.Context) (*ProductTag, error) {
+      return service.FindByName(ctx, name)
+    },
+    func(ctx context.Context) (*ProductTag, error) {
+      created, _, err := service.CreateWithContext(ctx, &ProductTag{Name: name})
+      return created, err
+    },
+    func(ctx context.Context, termID string) (*ProductTag, error) {
+      existing, _, err := service.GetWithContext(ctx, termID)
+      return existing, err
+    },
+  )
+}
\ No newline at end of file
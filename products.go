@@ -1,7 +1,7 @@
 package woocommerce
 
 import (
-  "net/http"
+  "context"
 )
 
 // Product service
@@ -74,6 +74,10 @@ type Product struct {
   Tags                   *[]ProductTag        `json:"tags,omitempty"`
   Attributes             *[]ProductAttributes `json:"attributes,omitempty"`
   DefaultAttributes      *[]DefaultAttributes `json:"default_attributes,omitempty"`
+
+  // Legacy fields of the v1 and v2 API (replaced by StockStatus). InStock is a pointer,
+  // so that false can be sent to mark a product out of stock.
+  InStock                *bool                `json:"in_stock,omitempty"`
 }
 
 type ProductDownloads struct {
@@ -88,18 +92,6 @@ type ProductDimensions struct {
   Height  string      `json:"height,omitempty"`
 }
 
-type ProductCategory struct {
-  Id                 int         `json:"id,omitempty"`
-  Name               string      `json:"name,omitempty"`
-  Slug               string      `json:"slug,omitempty"`
-}
-
-type ProductTag struct {
-  Id                 int         `json:"id,omitempty"`
-  Name               string      `json:"name,omitempty"`
-  Slug               string      `json:"slug,omitempty"`
-}
-
 type Image struct {
   Id                 interface{} `json:"id,omitempty"`
   DateCreated        string      `json:"date_created,omitempty"`
@@ -178,9 +170,14 @@ type BatchProductUpdateResponse struct {
 }
 
 // Create a product. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-product
-func (service *ProductsService) Create(product *Product) (*Product, *http.Response, error) {
+func (service *ProductsService) Create(product *Product) (*Product, *Response, error) {
+  return service.CreateWithContext(context.Background(), product)
+}
+
+// Create a product with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-product
+func (service *ProductsService) CreateWithContext(ctx context.Context, product *Product) (*Product, *Response, error) {
   _url := "/products" 
-  req, _ := service.client.NewRequest("POST", _url, nil, product)
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, product)
 
   createdProduct := new(Product)
   response, err := service.client.Do(req, createdProduct)
@@ -193,9 +190,14 @@ func (service *ProductsService) Create(product *Product) (*Product, *http.Respon
 }
 
 // Get a product. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-product
-func (service *ProductsService) Get(productID string) (*Product, *http.Response, error) {
+func (service *ProductsService) Get(productID string) (*Product, *Response, error) {
+  return service.GetWithContext(context.Background(), productID)
+}
+
+// Get a product with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-product
+func (service *ProductsService) GetWithContext(ctx context.Context, productID string) (*Product, *Response, error) {
   _url := "/products/" + productID
-  req, _ := service.client.NewRequest("GET", _url, nil, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
 
   product := new(Product)
   response, err := service.client.Do(req, product)
@@ -208,9 +210,14 @@ func (service *ProductsService) Get(productID string) (*Product, *http.Response,
 }
 
 // List products. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-products
-func (service *ProductsService) List(opts *ListProductParams) (*[]Product, *http.Response, error) {
+func (service *ProductsService) List(opts *ListProductParams) (*[]Product, *Response, error) {
+  return service.ListWithContext(context.Background(), opts)
+}
+
+// List products with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-products
+func (service *ProductsService) ListWithContext(ctx context.Context, opts *ListProductParams) (*[]Product, *Response, error) {
   _url := "/products"
-  req, _ := service.client.NewRequest("GET", _url, opts, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
 
   products := new([]Product)
   response, err := service.client.Do(req, products)
@@ -223,9 +230,14 @@ func (service *ProductsService) List(opts *ListProductParams) (*[]Product, *http
 }
 
 // Update a product. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-product
-func (service *ProductsService) Update(productID string, product *Product) (*Product, *http.Response, error) {
+func (service *ProductsService) Update(productID string, product *Product) (*Product, *Response, error) {
+  return service.UpdateWithContext(context.Background(), productID, product)
+}
+
+// Update a product with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-product
+func (service *ProductsService) UpdateWithContext(ctx context.Context, productID string, product *Product) (*Product, *Response, error) {
   _url := "/products/" + productID
-  req, _ := service.client.NewRequest("PUT", _url, nil, product)
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, product)
 
   updatedProduct := new(Product)
   response, err := service.client.Do(req, updatedProduct)
@@ -238,9 +250,14 @@ func (service *ProductsService) Update(productID string, product *Product) (*Pro
 }
 
 // Delete a product. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-product
-func (service *ProductsService) Delete(productID string, opts *DeleteProductParams) (*Product, *http.Response, error) {
+func (service *ProductsService) Delete(productID string, opts *DeleteProductParams) (*Product, *Response, error) {
+  return service.DeleteWithContext(context.Background(), productID, opts)
+}
+
+// Delete a product with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-product
+func (service *ProductsService) DeleteWithContext(ctx context.Context, productID string, opts *DeleteProductParams) (*Product, *Response, error) {
   _url := "/products/" + productID
-  req, _ := service.client.NewRequest("DELETE", _url, opts, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
 
   product := new(Product)
   response, err := service.client.Do(req, product)
@@ -253,9 +270,14 @@ func (service *ProductsService) Delete(productID string, opts *DeleteProductPara
 }
 
 // Batch update products. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-products
-func (service *ProductsService) Batch(opts *BatchProductUpdate) (*BatchProductUpdateResponse, *http.Response, error) {
+func (service *ProductsService) Batch(opts *BatchProductUpdate) (*BatchProductUpdateResponse, *Response, error) {
+  return service.BatchWithContext(context.Background(), opts)
+}
+
+// Batch update products with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-products
+func (service *ProductsService) BatchWithContext(ctx context.Context, opts *BatchProductUpdate) (*BatchProductUpdateResponse, *Response, error) {
   _url := "/products/batch"
-  req, _ := service.client.NewRequest("POST", _url, nil, opts)
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, opts)
 
   products := new(BatchProductUpdateResponse)
   response, err := service.client.Do(req, products)
@@ -265,4 +287,29 @@ func (service *ProductsService) Batch(opts *BatchProductUpdate) (*BatchProductUp
   }
 
   return products, response, nil
+}
+
+// Paginate products, fetching each page as it is iterated
+func (service *ProductsService) Paginate(opts *ListProductParams) *Paginator[Product] {
+  params := ListProductParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]Product, *Response, error) {
+    params.Page = page
+    products, response, err := service.ListWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *products, response, nil
+  })
+}
+
+// List all products across every page
+func (service *ProductsService) ListAll(ctx context.Context, opts *ListProductParams) ([]Product, error) {
+  return service.Paginate(opts).Collect(ctx)
 }
\ No newline at end of file
@@ -1,7 +1,7 @@
 package woocommerce
 
 import (
-  "net/http"
+  "context"
 )
 
 // Refunds service
@@ -69,9 +69,14 @@ type DeleteRefundParams struct {
 }
 
 // Create a refund. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-refund
-func (service *RefundsService) Create(orderId string, refund *Refund) (*Refund, *http.Response, error) {
+func (service *RefundsService) Create(orderId string, refund *Refund) (*Refund, *Response, error) {
+  return service.CreateWithContext(context.Background(), orderId, refund)
+}
+
+// Create a refund with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-refund
+func (service *RefundsService) CreateWithContext(ctx context.Context, orderId string, refund *Refund) (*Refund, *Response, error) {
   _url := "/orders/" + orderId + "/refunds"
-  req, _ := service.client.NewRequest("POST", _url, nil, refund)
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, refund)
 
   createdRefund := new(Refund)
   response, err := service.client.Do(req, createdRefund)
@@ -84,9 +89,14 @@ func (service *RefundsService) Create(orderId string, refund *Refund) (*Refund,
 }
 
 // Get a refund. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-refund
-func (service *RefundsService) Get(orderId string, refundId string) (*Refund, *http.Response, error) {
+func (service *RefundsService) Get(orderId string, refundId string) (*Refund, *Response, error) {
+  return service.GetWithContext(context.Background(), orderId, refundId)
+}
+
+// Get a refund with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-refund
+func (service *RefundsService) GetWithContext(ctx context.Context, orderId string, refundId string) (*Refund, *Response, error) {
   _url := "/orders/" + orderId + "/refunds/" + refundId
-  req, _ := service.client.NewRequest("GET", _url, nil, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
 
   refund := new(Refund)
   response, err := service.client.Do(req, refund)
@@ -99,9 +109,14 @@ func (service *RefundsService) Get(orderId string, refundId string) (*Refund, *h
 }
 
 // List orders. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-refunds
-func (service *RefundsService) List(orderId string, opts *ListRefundParams) (*[]Refund, *http.Response, error) {
+func (service *RefundsService) List(orderId string, opts *ListRefundParams) (*[]Refund, *Response, error) {
+  return service.ListWithContext(context.Background(), orderId, opts)
+}
+
+// List orders with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-refunds
+func (service *RefundsService) ListWithContext(ctx context.Context, orderId string, opts *ListRefundParams) (*[]Refund, *Response, error) {
   _url := "/orders/" + orderId + "/refunds"
-  req, _ := service.client.NewRequest("GET", _url, opts, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
 
   orders := new([]Refund)
   response, err := service.client.Do(req, orders)
@@ -114,9 +129,14 @@ func (service *RefundsService) List(orderId string, opts *ListRefundParams) (*[]
 }
 
 // Delete a refund. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-refund
-func (service *RefundsService) Delete(orderId string, refundId string, opts *DeleteRefundParams) (*Refund, *http.Response, error) {
+func (service *RefundsService) Delete(orderId string, refundId string, opts *DeleteRefundParams) (*Refund, *Response, error) {
+  return service.DeleteWithContext(context.Background(), orderId, refundId, opts)
+}
+
+// Delete a refund with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-refund
+func (service *RefundsService) DeleteWithContext(ctx context.Context, orderId string, refundId string, opts *DeleteRefundParams) (*Refund, *Response, error) {
   _url := "/orders/" + orderId + "/refunds/" + refundId
-  req, _ := service.client.NewRequest("DELETE", _url, opts, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
 
   refund := new(Refund)
   response, err := service.client.Do(req, refund)
@@ -126,4 +146,29 @@ func (service *RefundsService) Delete(orderId string, refundId string, opts *Del
   }
 
   return refund, response, nil
+}
+
+// Paginate order refunds, fetching each page as it is iterated
+func (service *RefundsService) Paginate(orderId string, opts *ListRefundParams) *Paginator[Refund] {
+  params := ListRefundParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]Refund, *Response, error) {
+    params.Page = page
+    refunds, response, err := service.ListWithContext(ctx, orderId, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *refunds, response, nil
+  })
+}
+
+// List all order refunds across every page
+func (service *RefundsService) ListAll(ctx context.Context, orderId string, opts *ListRefundParams) ([]Refund, error) {
+  return service.Paginate(orderId, opts).Collect(ctx)
 }
\ No newline at end of file
@@ -0,0 +1,238 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// Reports service
+type ReportsService service
+
+// Report periods, used instead of a date range
+const (
+  ReportPeriodWeek      = "week"
+  ReportPeriodMonth     = "month"
+  ReportPeriodLastMonth = "last_month"
+  ReportPeriodYear      = "year"
+)
+
+// Report object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-reports
+type Report struct {
+  Slug         string      `json:"slug,omitempty"`
+  Description  string      `json:"description,omitempty"`
+  Links        *Links      `json:"_links,omitempty"`
+}
+
+// SalesReport object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#sales-report-properties
+type SalesReport struct {
+  TotalSales       string                       `json:"total_sales,omitempty"`
+  NetSales         string                       `json:"net_sales,omitempty"`
+  AverageSales     string                       `json:"average_sales,omitempty"`
+  TotalOrders      int                          `json:"total_orders,omitempty"`
+  TotalItems       int                          `json:"total_items,omitempty"`
+  TotalTax         string                       `json:"total_tax,omitempty"`
+  TotalShipping    string                       `json:"total_shipping,omitempty"`
+  TotalRefunds     float64                      `json:"total_refunds,omitempty"`
+  TotalDiscount    string                       `json:"total_discount,omitempty"`
+  TotalsGroupedBy  string                       `json:"totals_grouped_by,omitempty"`
+  Totals           map[string]SalesReportTotals `json:"totals,omitempty"`
+  TotalCustomers   int                          `json:"total_customers,omitempty"`
+  Links            *Links                       `json:"_links,omitempty"`
+}
+
+// SalesReportTotals are the sales totals of a period (day or month, see TotalsGroupedBy)
+type SalesReportTotals struct {
+  Sales      string    `json:"sales,omitempty"`
+  Orders     int       `json:"orders,omitempty"`
+  Items      int       `json:"items,omitempty"`
+  Tax        string    `json:"tax,omitempty"`
+  Shipping   string    `json:"shipping,omitempty"`
+  Discount   string    `json:"discount,omitempty"`
+  Customers  int       `json:"customers,omitempty"`
+}
+
+// TopSellersReport object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#top-sellers-report-properties
+type TopSellersReport struct {
+  Title      string    `json:"title,omitempty"`
+  ProductId  int       `json:"product_id,omitempty"`
+  Quantity   int       `json:"quantity,omitempty"`
+  Links      *Links    `json:"_links,omitempty"`
+}
+
+// ReportTotal object, returned by the orders, products, customers, coupons and reviews totals reports
+type ReportTotal struct {
+  Slug   string    `json:"slug,omitempty"`
+  Name   string    `json:"name,omitempty"`
+  Total  int       `json:"total"`
+}
+
+type ListReportsParams struct {
+  Context  string    `url:"context,omitempty"`
+}
+
+// SalesReportParams filter the sales and top sellers reports by period, or by a date range (YYYY-MM-DD)
+type SalesReportParams struct {
+  Context  string    `url:"context,omitempty"`
+  Period   string    `url:"period,omitempty"`
+  DateMin  string    `url:"date_min,omitempty"`
+  DateMax  string    `url:"date_max,omitempty"`
+}
+
+// List all reports. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-reports
+func (service *ReportsService) List(opts *ListReportsParams) (*[]Report, *Response, error) {
+  return service.ListWithContext(context.Background(), opts)
+}
+
+// List all reports with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-reports
+func (service *ReportsService) ListWithContext(ctx context.Context, opts *ListReportsParams) (*[]Report, *Response, error) {
+  _url := "/reports"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  reports := new([]Report)
+  response, err := service.client.Do(req, reports)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return reports, response, nil
+}
+
+// Retrieve the sales report. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-sales-report
+func (service *ReportsService) Sales(opts *SalesReportParams) (*[]SalesReport, *Response, error) {
+  return service.SalesWithContext(context.Background(), opts)
+}
+
+// Retrieve the sales report with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-sales-report
+func (service *ReportsService) SalesWithContext(ctx context.Context, opts *SalesReportParams) (*[]SalesReport, *Response, error) {
+  _url := "/reports/sales"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  reports := new([]SalesReport)
+  response, err := service.client.Do(req, reports)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return reports, response, nil
+}
+
+// Retrieve the top sellers report. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-top-sellers-report
+func (service *ReportsService) TopSellers(opts *SalesReportParams) (*[]TopSellersReport, *Response, error) {
+  return service.TopSellersWithContext(context.Background(), opts)
+}
+
+// Retrieve the top sellers report with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-top-sellers-report
+func (service *ReportsService) TopSellersWithContext(ctx context.Context, opts *SalesReportParams) (*[]TopSellersReport, *Response, error) {
+  _url := "/reports/top_sellers"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  reports := new([]TopSellersReport)
+  response, err := service.client.Do(req, reports)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return reports, response, nil
+}
+
+// Retrieve the orders totals report. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-orders-totals
+func (service *ReportsService) OrdersTotals(opts *ListReportsParams) (*[]ReportTotal, *Response, error) {
+  return service.OrdersTotalsWithContext(context.Background(), opts)
+}
+
+// Retrieve the orders totals report with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-orders-totals
+func (service *ReportsService) OrdersTotalsWithContext(ctx context.Context, opts *ListReportsParams) (*[]ReportTotal, *Response, error) {
+  _url := "/reports/orders/totals"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  totals := new([]ReportTotal)
+  response, err := service.client.Do(req, totals)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return totals, response, nil
+}
+
+// Retrieve the products totals report. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-products-totals
+func (service *ReportsService) ProductsTotals(opts *ListReportsParams) (*[]ReportTotal, *Response, error) {
+  return service.ProductsTotalsWithContext(context.Background(), opts)
+}
+
+// Retrieve the products totals report with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-products-totals
+func (service *ReportsService) ProductsTotalsWithContext(ctx context.Context, opts *ListReportsParams) (*[]ReportTotal, *Response, error) {
+  _url := "/reports/products/totals"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  totals := new([]ReportTotal)
+  response, err := service.client.Do(req, totals)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return totals, response, nil
+}
+
+// Retrieve the customers totals report. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-customers-totals
+func (service *ReportsService) CustomersTotals(opts *ListReportsParams) (*[]ReportTotal, *Response, error) {
+  return service.CustomersTotalsWithContext(context.Background(), opts)
+}
+
+// Retrieve the customers totals report with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-customers-totals
+func (service *ReportsService) CustomersTotalsWithContext(ctx context.Context, opts *ListReportsParams) (*[]ReportTotal, *Response, error) {
+  _url := "/reports/customers/totals"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  totals := new([]ReportTotal)
+  response, err := service.client.Do(req, totals)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return totals, response, nil
+}
+
+// Retrieve the coupons totals report. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-coupons-totals
+func (service *ReportsService) CouponsTotals(opts *ListReportsParams) (*[]ReportTotal, *Response, error) {
+  return service.CouponsTotalsWithContext(context.Background(), opts)
+}
+
+// Retrieve the coupons totals report with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-coupons-totals
+func (service *ReportsService) CouponsTotalsWithContext(ctx context.Context, opts *ListReportsParams) (*[]ReportTotal, *Response, error) {
+  _url := "/reports/coupons/totals"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  totals := new([]ReportTotal)
+  response, err := service.client.Do(req, totals)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return totals, response, nil
+}
+
+// Retrieve the reviews totals report. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-reviews-totals
+func (service *ReportsService) ReviewsTotals(opts *ListReportsParams) (*[]ReportTotal, *Response, error) {
+  return service.ReviewsTotalsWithContext(context.Background(), opts)
+}
+
+// Retrieve the reviews totals report with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-reviews-totals
+func (service *ReportsService) ReviewsTotalsWithContext(ctx context.Context, opts *ListReportsParams) (*[]ReportTotal, *Response, error) {
+  _url := "/reports/reviews/totals"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  totals := new([]ReportTotal)
+  response, err := service.client.Do(req, totals)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return totals, response, nil
+}
\ No newline at end of file
@@ -0,0 +1,205 @@
+package woocommerce
+
+import (
+  "context"
+  "net/url"
+  "strconv"
+  "strings"
+
+  "github.com/google/go-querystring/query"
+)
+
+// Resource is a typed client for a custom route, such as the endpoints added by plugins (eg. bookings or
+// memberships). T is the resource object, and P the list parameters struct (with `url` tags). Requests
+// go through the client, so they share its authentication, retries, pagination and errors.
+type Resource[T any, P any] struct {
+  client    *Client
+  namespace string
+  path      string
+}
+
+// DeleteResourceParams are the parameters to delete a resource object
+type DeleteResourceParams struct {
+  Force    bool       `url:"force"`
+}
+
+type BatchResourceUpdate[T any] struct {
+  Create  *[]T   `json:"create,omitempty"`
+  Update  *[]T   `json:"update,omitempty"`
+  Delete  *[]int `json:"delete,omitempty"`
+}
+
+type BatchResourceUpdateResponse[T any] struct {
+  Create  *[]T `json:"create,omitempty"`
+  Update  *[]T `json:"update,omitempty"`
+  Delete  *[]T `json:"delete,omitempty"`
+}
+
+// NewResource creates a typed client for the route at the given path (eg. "/bookings"). The namespace
+// (eg. "wc-bookings/v1") is used as given, and defaults to the client namespace when empty.
+func NewResource[T any, P any](client *Client, namespace string, path string) *Resource[T, P] {
+  if path != "" && !strings.HasPrefix(path, "/") {
+    path = "/" + path
+  }
+
+  return &Resource[T, P]{client: client, namespace: namespace, path: path}
+}
+
+// Create an object
+func (resource *Resource[T, P]) Create(object *T) (*T, *Response, error) {
+  return resource.CreateWithContext(context.Background(), object)
+}
+
+// Create an object with context
+func (resource *Resource[T, P]) CreateWithContext(ctx context.Context, object *T) (*T, *Response, error) {
+  createdObject := new(T)
+  response, err := resource.Call(ctx, "POST", "", nil, object, createdObject)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return createdObject, response, nil
+}
+
+// Get an object
+func (resource *Resource[T, P]) Get(objectID string) (*T, *Response, error) {
+  return resource.GetWithContext(context.Background(), objectID)
+}
+
+// Get an object with context
+func (resource *Resource[T, P]) GetWithContext(ctx context.Context, objectID string) (*T, *Response, error) {
+  object := new(T)
+  response, err := resource.Call(ctx, "GET", "/"+objectID, nil, nil, object)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return object, response, nil
+}
+
+// List objects
+func (resource *Resource[T, P]) List(opts *P) (*[]T, *Response, error) {
+  return resource.ListWithContext(context.Background(), opts)
+}
+
+// List objects with context
+func (resource *Resource[T, P]) ListWithContext(ctx context.Context, opts *P) (*[]T, *Response, error) {
+  objects := new([]T)
+  response, err := resource.Call(ctx, "GET", "", opts, nil, objects)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return objects, response, nil
+}
+
+// Update an object
+func (resource *Resource[T, P]) Update(objectID string, object *T) (*T, *Response, error) {
+  return resource.UpdateWithContext(context.Background(), objectID, object)
+}
+
+// Update an object with context
+func (resource *Resource[T, P]) UpdateWithContext(ctx context.Context, objectID string, object *T) (*T, *Response, error) {
+  updatedObject := new(T)
+  response, err := resource.Call(ctx, "PUT", "/"+objectID, nil, object, updatedObject)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return updatedObject, response, nil
+}
+
+// Delete an object
+func (resource *Resource[T, P]) Delete(objectID string, opts *DeleteResourceParams) (*T, *Response, error) {
+  return resource.DeleteWithContext(context.Background(), objectID, opts)
+}
+
+// Delete an object with context
+func (resource *Resource[T, P]) DeleteWithContext(ctx context.Context, objectID string, opts *DeleteResourceParams) (*T, *Response, error) {
+  object := new(T)
+  response, err := resource.Call(ctx, "DELETE", "/"+objectID, opts, nil, object)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return object, response, nil
+}
+
+// Batch create, update and delete objects
+func (resource *Resource[T, P]) Batch(opts *BatchResourceUpdate[T]) (*BatchResourceUpdateResponse[T], *Response, error) {
+  return resource.BatchWithContext(context.Background(), opts)
+}
+
+// Batch create, update and delete objects with context
+func (resource *Resource[T, P]) BatchWithContext(ctx context.Context, opts *BatchResourceUpdate[T]) (*BatchResourceUpdateResponse[T], *Response, error) {
+  objects := new(BatchResourceUpdateResponse[T])
+  response, err := resource.Call(ctx, "POST", "/batch", nil, opts, objects)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return objects, response, nil
+}
+
+// Paginate objects, fetching each page as it is iterated. The page is sent as the "page" query
+// parameter, starting from the page set in the params (if any).
+func (resource *Resource[T, P]) Paginate(opts *P) *Paginator[T] {
+  params := url.Values{}
+  var encodeErr error
+
+  if opts != nil {
+    params, encodeErr = query.Values(opts)
+  }
+
+  firstPage, _ := strconv.Atoi(params.Get("page"))
+
+  return newPaginator(firstPage, func(ctx context.Context, page int) ([]T, *Response, error) {
+    // Params could not be encoded? (reported on the first page)
+    if encodeErr != nil {
+      return nil, nil, encodeErr
+    }
+
+    pageParams := url.Values{}
+
+    for key, values := range params {
+      pageParams[key] = values
+    }
+
+    pageParams.Set("page", strconv.Itoa(page))
+
+    objects := []T{}
+    response, err := resource.Call(ctx, "GET", "", pageParams, nil, &objects)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return objects, response, nil
+  })
+}
+
+// List all objects across every page
+func (resource *Resource[T, P]) ListAll(ctx context.Context, opts *P) ([]T, error) {
+  return resource.Paginate(opts).Collect(ctx)
+}
+
+// Call sends a request to a sub-path of the resource (eg. "/42/confirm" for a custom action), and
+// decodes the response into v. The opts are a params struct (with `url` tags), or url.Values.
+func (resource *Resource[T, P]) Call(ctx context.Context, method string, subPath string, opts interface{}, body interface{}, v interface{}) (*Response, error) {
+  if resource.namespace != "" {
+    ctx = contextWithRawNamespace(ctx, resource.namespace)
+  }
+
+  req, err := resource.client.NewRequestWithContext(ctx, method, resource.path+subPath, opts, body)
+  if err != nil {
+    return nil, err
+  }
+
+  return resource.client.Do(req, v)
+}
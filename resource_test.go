@@ -0,0 +1,148 @@
+package woocommerce
+
+import (
+  "context"
+  "encoding/json"
+  "fmt"
+  "net/http"
+  "net/http/httptest"
+  "net/url"
+  "strconv"
+  "testing"
+)
+
+type testBooking struct {
+  Id     int    `json:"id,omitempty"`
+  Status string `json:"status,omitempty"`
+}
+
+type testBookingParams struct {
+  Page    int    `url:"page,omitempty"`
+  PerPage int    `url:"per_page,omitempty"`
+  Status  string `url:"status,omitempty"`
+  Include []int  `url:"include,omitempty,comma"`
+}
+
+// newTestResourceServer answers every request with three pages of bookings, and records the request URLs
+func newTestResourceServer(t *testing.T) (*Client, *[]*url.URL) {
+  requests := &[]*url.URL{}
+
+  server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    *requests = append(*requests, r.URL)
+
+    page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+    w.Header().Set(headerTotalPages, "3")
+
+    if r.URL.Query().Has("page") {
+      json.NewEncoder(w).Encode([]testBooking{{Id: page * 10}, {Id: page*10 + 1}})
+
+      return
+    }
+
+    fmt.Fprint(w, `{"id":42,"status":"confirmed"}`)
+  }))
+
+  t.Cleanup(server.Close)
+
+  client, err := New(server.URL)
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  return client, requests
+}
+
+func TestResourcePaths(t *testing.T) {
+  client, requests := newTestResourceServer(t)
+
+  tests := []struct {
+    name      string
+    namespace string
+    path      string
+    want      string
+  }{
+    {name: "plugin namespace", namespace: "wc-bookings/v1", path: "bookings", want: "/wp-json/wc-bookings/v1/bookings/42"},
+    {name: "leading slash", namespace: "wc-bookings/v1", path: "/bookings", want: "/wp-json/wc-bookings/v1/bookings/42"},
+    {name: "namespace without version", namespace: "bookings", path: "items", want: "/wp-json/bookings/items/42"},
+    {name: "client namespace", namespace: "", path: "bookings", want: "/wp-json/wc/v3/bookings/42"},
+  }
+
+  for _, test := range tests {
+    t.Run(test.name, func(t *testing.T) {
+      bookings := NewResource[testBooking, testBookingParams](client, test.namespace, test.path)
+
+      booking, _, err := bookings.Get("42")
+      if err != nil {
+        t.Fatal(err)
+      }
+
+      if got := (*requests)[len(*requests)-1].Path; got != test.want {
+        t.Errorf("path = %s, want %s", got, test.want)
+      }
+
+      if booking.Id != 42 || booking.Status != "confirmed" {
+        t.Errorf("booking = %+v, want 42 confirmed", booking)
+      }
+    })
+  }
+}
+
+func TestResourcePaginateKeepsParams(t *testing.T) {
+  client, requests := newTestResourceServer(t)
+  bookings := NewResource[testBooking, testBookingParams](client, "wc-bookings/v1", "bookings")
+
+  all, err := bookings.ListAll(context.Background(), &testBookingParams{Page: 2, PerPage: 2, Status: "confirmed", Include: []int{20, 21, 30}})
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  if len(all) != 4 || all[0].Id != 20 || all[3].Id != 31 {
+    t.Errorf("bookings = %+v, want pages 2 and 3", all)
+  }
+
+  if len(*requests) != 2 {
+    t.Fatalf("requests = %d, want 2", len(*requests))
+  }
+
+  for i, request := range *requests {
+    query := request.Query()
+
+    if want := strconv.Itoa(i + 2); query.Get("page") != want || len(query["page"]) != 1 {
+      t.Errorf("request %d page = %v, want %s", i, query["page"], want)
+    }
+
+    if query.Get("per_page") != "2" || query.Get("status") != "confirmed" || query.Get("include") != "20,21,30" {
+      t.Errorf("request %d query = %s, want the list params", i, request.RawQuery)
+    }
+  }
+}
+
+func TestResourceCallEncodesQuery(t *testing.T) {
+  client, requests := newTestResourceServer(t)
+  bookings := NewResource[testBooking, testBookingParams](client, "wc-bookings/v1", "bookings")
+
+  booking := new(testBooking)
+  opts := url.Values{"notify": {"true"}, "note": {"Moved to 10:00 & confirmed"}, "resource[]": {"3", "4"}}
+
+  if _, err := bookings.Call(context.Background(), "POST", "/42/confirm", opts, nil, booking); err != nil {
+    t.Fatal(err)
+  }
+
+  request := (*requests)[0]
+
+  if request.Path != "/wp-json/wc-bookings/v1/bookings/42/confirm" {
+    t.Errorf("path = %s, want /wp-json/wc-bookings/v1/bookings/42/confirm", request.Path)
+  }
+
+  if request.RawQuery != opts.Encode() {
+    t.Errorf("query = %s, want %s", request.RawQuery, opts.Encode())
+  }
+
+  if query := request.Query(); query.Get("note") != "Moved to 10:00 & confirmed" || len(query["resource[]"]) != 2 {
+    t.Errorf("decoded query = %v, want the original values", query)
+  }
+
+  if booking.Id != 42 {
+    t.Errorf("booking = %+v, want 42", booking)
+  }
+}
@@ -0,0 +1,112 @@
+package woocommerce
+
+import (
+  "net/http"
+  "net/url"
+  "strconv"
+  "strings"
+  "time"
+)
+
+const (
+  headerTotalItems = "X-WP-Total"
+  headerTotalPages = "X-WP-TotalPages"
+  headerLink       = "Link"
+)
+
+// Response wraps the HTTP response, with pagination and rate limit headers parsed
+type Response struct {
+  *http.Response
+
+  TotalItems int
+  TotalPages int
+  NextPage   int
+  PrevPage   int
+
+  Rate Rate
+}
+
+// Rate holds the rate limit headers of a response (zero when not sent by the store)
+type Rate struct {
+  Limit      int
+  Remaining  int
+  Reset      time.Time
+  RetryAfter time.Duration
+}
+
+func newResponse(httpResponse *http.Response) *Response {
+  if httpResponse == nil {
+    return nil
+  }
+
+  response := &Response{Response: httpResponse}
+
+  response.TotalItems, _ = strconv.Atoi(httpResponse.Header.Get(headerTotalItems))
+  response.TotalPages, _ = strconv.Atoi(httpResponse.Header.Get(headerTotalPages))
+
+  response.populatePageValues()
+  response.populateRate()
+
+  return response
+}
+
+// populatePageValues parses the next and previous pages from the Link header
+func (response *Response) populatePageValues() {
+  for _, link := range strings.Split(response.Header.Get(headerLink), ",") {
+    segments := strings.Split(strings.TrimSpace(link), ";")
+
+    if len(segments) < 2 {
+      continue
+    }
+
+    rawURL := strings.Trim(strings.TrimSpace(segments[0]), "<>")
+
+    linkURL, err := url.Parse(rawURL)
+    if err != nil {
+      continue
+    }
+
+    page, err := strconv.Atoi(linkURL.Query().Get("page"))
+    if err != nil {
+      // Link without page param is the first page
+      page = 1
+    }
+
+    for _, segment := range segments[1:] {
+      switch strings.TrimSpace(segment) {
+      case `rel="next"`:
+        response.NextPage = page
+      case `rel="prev"`:
+        response.PrevPage = page
+      }
+    }
+  }
+}
+
+// populateRate parses the rate limit headers (eg. sent by the Store API rate limiter)
+func (response *Response) populateRate() {
+  response.Rate.Limit = headerInt(response.Header, "RateLimit-Limit", "X-RateLimit-Limit")
+  response.Rate.Remaining = headerInt(response.Header, "RateLimit-Remaining", "X-RateLimit-Remaining")
+
+  // Reset is either a unix timestamp, or a number of seconds from now
+  if reset := headerInt(response.Header, "RateLimit-Reset", "X-RateLimit-Reset"); reset > 0 {
+    if reset > 1000000000 {
+      response.Rate.Reset = time.Unix(int64(reset), 0)
+    } else {
+      response.Rate.Reset = time.Now().Add(time.Duration(reset) * time.Second)
+    }
+  }
+
+  response.Rate.RetryAfter, _ = parseRetryAfter(response.Header.Get("Retry-After"))
+}
+
+// headerInt returns the first integer header value found
+func headerInt(header http.Header, names ...string) int {
+  for _, name := range names {
+    if value, err := strconv.Atoi(header.Get(name)); err == nil {
+      return value
+    }
+  }
+
+  return 0
+}
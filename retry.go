@@ -0,0 +1,155 @@
+package woocommerce
+
+import (
+  "math"
+  "math/rand"
+  "net/http"
+  "strconv"
+  "strings"
+  "time"
+)
+
+// maxBackoffDoublings caps the exponent of the exponential backoff
+const maxBackoffDoublings = 30
+
+// RetryPolicy controls when and how failed requests are retried
+type RetryPolicy struct {
+  // MaxAttempts is the total number of attempts, including the first one
+  MaxAttempts int
+
+  // MinBackoff is the wait before the first retry, doubled on each later retry
+  MinBackoff time.Duration
+
+  // MaxBackoff caps the exponential backoff (a Retry-After header may exceed it)
+  MaxBackoff time.Duration
+
+  // Jitter randomises each wait between half and all of the computed backoff
+  Jitter bool
+
+  // RetryMethods are the HTTP methods that may be replayed after a network
+  // or server error. Rate limited requests (HTTP 429) are retried for any method,
+  // as the server did not process them.
+  RetryMethods []string
+
+  // CheckRetry overrides the default retry decision when set
+  CheckRetry func(req *http.Request, resp *http.Response, err error) bool
+}
+
+// DefaultRetryPolicy returns the retry policy used when none is configured.
+// Non-idempotent POST requests (eg. creating an order or a refund) are only
+// retried when rate limited.
+func DefaultRetryPolicy() *RetryPolicy {
+  return &RetryPolicy{
+    MaxAttempts:  defaultRetryMaxAttempts,
+    MinBackoff:   defaultRetryMinBackoff,
+    MaxBackoff:   defaultRetryMaxBackoff,
+    Jitter:       true,
+    RetryMethods: []string{"GET", "HEAD", "OPTIONS", "PUT", "DELETE"},
+  }
+}
+
+// NoRetryPolicy returns a policy that sends every request exactly once
+func NoRetryPolicy() *RetryPolicy {
+  return &RetryPolicy{MaxAttempts: 1}
+}
+
+// attempts returns the number of attempts allowed by the policy
+func (policy *RetryPolicy) attempts() int {
+  if policy.MaxAttempts < 1 {
+    return 1
+  }
+
+  return policy.MaxAttempts
+}
+
+// shouldRetry checks if the request attempt should be retried
+func (policy *RetryPolicy) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+  if policy.CheckRetry != nil {
+    return policy.CheckRetry(req, resp, err)
+  }
+
+  // Request was cancelled, or its deadline exceeded? (never retry)
+  if req.Context().Err() != nil {
+    return false
+  }
+
+  // Rate limited? (request was not processed, safe to replay)
+  if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+    return true
+  }
+
+  if !policy.allowsMethod(req.Method) {
+    return false
+  }
+
+  return checkRequestRetry(resp, err)
+}
+
+// allowsMethod checks if the HTTP method may be replayed
+func (policy *RetryPolicy) allowsMethod(method string) bool {
+  for _, allowed := range policy.RetryMethods {
+    if strings.EqualFold(allowed, method) {
+      return true
+    }
+  }
+
+  return false
+}
+
+// backoff returns how long to wait before the given retry (1 for the first retry)
+func (policy *RetryPolicy) backoff(retry int, resp *http.Response) time.Duration {
+  // Server told us how long to wait? (HTTP 429 or 503)
+  if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+    if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+      return wait
+    }
+  }
+
+  wait := policy.MinBackoff
+
+  // Clamp the exponent, so the wait cannot overflow when no MaxBackoff is set
+  for i := 1; i < min(retry, maxBackoffDoublings+1) && (policy.MaxBackoff <= 0 || wait < policy.MaxBackoff); i++ {
+    if wait > math.MaxInt64/2 {
+      break
+    }
+
+    wait *= 2
+  }
+
+  if policy.MaxBackoff > 0 && wait > policy.MaxBackoff {
+    wait = policy.MaxBackoff
+  }
+
+  if policy.Jitter && wait > 1 {
+    wait = wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+  }
+
+  return wait
+}
+
+// parseRetryAfter parses a Retry-After header, in either seconds or HTTP date form
+func parseRetryAfter(value string) (time.Duration, bool) {
+  if value == "" {
+    return 0, false
+  }
+
+  if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+    if int64(seconds) > math.MaxInt64/int64(time.Second) {
+      return time.Duration(math.MaxInt64), true
+    }
+
+    return time.Duration(seconds) * time.Second, true
+  }
+
+  if date, err := http.ParseTime(value); err == nil {
+    wait := time.Until(date)
+
+    if wait < 0 {
+      wait = 0
+    }
+
+    return wait, true
+  }
+
+  return 0, false
+}
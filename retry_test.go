@@ -0,0 +1,119 @@
+package woocommerce
+
+import (
+  "net/http"
+  "testing"
+  "time"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+  tests := []struct {
+    name   string
+    value  string
+    want   time.Duration
+    wantOk bool
+  }{
+    {name: "empty", value: "", wantOk: false},
+    {name: "zero seconds", value: "0", want: 0, wantOk: true},
+    {name: "seconds", value: "120", want: 2 * time.Minute, wantOk: true},
+    {name: "huge seconds", value: "99999999999999999", want: time.Duration(1<<63 - 1), wantOk: true},
+    {name: "negative seconds", value: "-5", wantOk: false},
+    {name: "fractional seconds", value: "1.5", wantOk: false},
+    {name: "garbage", value: "soon", wantOk: false},
+    {name: "past date", value: "Wed, 21 Oct 2015 07:28:00 GMT", want: 0, wantOk: true},
+  }
+
+  for _, test := range tests {
+    t.Run(test.name, func(t *testing.T) {
+      got, ok := parseRetryAfter(test.value)
+
+      if ok != test.wantOk || got != test.want {
+        t.Errorf("parseRetryAfter(%q) = %v, %v, want %v, %v", test.value, got, ok, test.want, test.wantOk)
+      }
+    })
+  }
+}
+
+func TestParseRetryAfterDate(t *testing.T) {
+  date := time.Now().Add(90 * time.Second).UTC().Format(http.TimeFormat)
+
+  got, ok := parseRetryAfter(date)
+
+  // The date has a one second precision
+  if !ok || got < 88*time.Second || got > 90*time.Second {
+    t.Errorf("parseRetryAfter(%q) = %v, %v, want about 90s", date, got, ok)
+  }
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+  tests := []struct {
+    name   string
+    policy RetryPolicy
+    retry  int
+    resp   *http.Response
+    want   time.Duration
+  }{
+    {name: "first retry", policy: RetryPolicy{MinBackoff: time.Second, MaxBackoff: 30 * time.Second}, retry: 1, want: time.Second},
+    {name: "doubled", policy: RetryPolicy{MinBackoff: time.Second, MaxBackoff: 30 * time.Second}, retry: 3, want: 4 * time.Second},
+    {name: "capped", policy: RetryPolicy{MinBackoff: time.Second, MaxBackoff: 30 * time.Second}, retry: 6, want: 30 * time.Second},
+    {name: "capped far retry", policy: RetryPolicy{MinBackoff: time.Second, MaxBackoff: 30 * time.Second}, retry: 1000, want: 30 * time.Second},
+    {name: "no cap", policy: RetryPolicy{MinBackoff: time.Second}, retry: 5, want: 16 * time.Second},
+    {name: "no cap exponent clamped", policy: RetryPolicy{MinBackoff: time.Millisecond}, retry: 1000, want: time.Millisecond << maxBackoffDoublings},
+    {name: "no cap no overflow", policy: RetryPolicy{MinBackoff: time.Hour}, retry: 1000, want: time.Hour << 21},
+    {
+      name:   "retry after on rate limit",
+      policy: RetryPolicy{MinBackoff: time.Second, MaxBackoff: 30 * time.Second},
+      retry:  1,
+      resp:   &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"45"}}},
+      want:   45 * time.Second,
+    },
+    {
+      name:   "retry after on unavailable",
+      policy: RetryPolicy{MinBackoff: time.Second},
+      retry:  2,
+      resp:   &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{"Retry-After": {"0"}}},
+      want:   0,
+    },
+    {
+      name:   "retry after ignored on server error",
+      policy: RetryPolicy{MinBackoff: time.Second},
+      retry:  2,
+      resp:   &http.Response{StatusCode: http.StatusInternalServerError, Header: http.Header{"Retry-After": {"45"}}},
+      want:   2 * time.Second,
+    },
+    {
+      name:   "garbage retry after",
+      policy: RetryPolicy{MinBackoff: time.Second},
+      retry:  1,
+      resp:   &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"later"}}},
+      want:   time.Second,
+    },
+  }
+
+  for _, test := range tests {
+    t.Run(test.name, func(t *testing.T) {
+      if got := test.policy.backoff(test.retry, test.resp); got != test.want {
+        t.Errorf("backoff(%d) = %v, want %v", test.retry, got, test.want)
+      }
+    })
+  }
+}
+
+func TestRetryPolicyBackoffJitter(t *testing.T) {
+  policy := RetryPolicy{MinBackoff: time.Second, MaxBackoff: 30 * time.Second, Jitter: true}
+
+  for _, retry := range []int{1, 3, 10} {
+    full := (&RetryPolicy{MinBackoff: policy.MinBackoff, MaxBackoff: policy.MaxBackoff}).backoff(retry, nil)
+
+    for i := 0; i < 100; i++ {
+      if got := policy.backoff(retry, nil); got < full/2 || got > full {
+        t.Fatalf("backoff(%d) = %v, want between %v and %v", retry, got, full/2, full)
+      }
+    }
+  }
+
+  // A zero backoff stays zero
+  if got := (&RetryPolicy{Jitter: true}).backoff(3, nil); got != 0 {
+    t.Errorf("backoff() = %v, want 0", got)
+  }
+}
@@ -0,0 +1,148 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// Settings service
+type SettingsService service
+
+// SettingGroup object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#setting-group-properties
+type SettingGroup struct {
+  Id           string      `json:"id,omitempty"`
+  Label        string      `json:"label,omitempty"`
+  Description  string      `json:"description,omitempty"`
+  ParentId     string      `json:"parent_id,omitempty"`
+  SubGroups    []string    `json:"sub_groups,omitempty"`
+  Links        *Links      `json:"_links,omitempty"`
+}
+
+// SettingOption object. Value is a string, or a list of strings for multiselect options.
+// Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#setting-option-properties
+type SettingOption struct {
+  Id           string             `json:"id,omitempty"`
+  Label        string             `json:"label,omitempty"`
+  Description  string             `json:"description,omitempty"`
+  Value        interface{}        `json:"value,omitempty"`
+  Default      interface{}        `json:"default,omitempty"`
+  Tip          string             `json:"tip,omitempty"`
+  Placeholder  string             `json:"placeholder,omitempty"`
+  Type         string             `json:"type,omitempty"`
+  Options      map[string]string  `json:"options,omitempty"`
+  GroupId      string             `json:"group_id,omitempty"`
+  Links        *Links             `json:"_links,omitempty"`
+}
+
+// SettingOptionUpdate is the body to update the value of a setting option
+type SettingOptionUpdate struct {
+  Id     string       `json:"id,omitempty"`
+  Value  interface{}  `json:"value"`
+}
+
+type BatchSettingOptionUpdate struct {
+  Update  *[]SettingOptionUpdate `json:"update,omitempty"`
+}
+
+type BatchSettingOptionUpdateResponse struct {
+  Update  *[]SettingOption `json:"update,omitempty"`
+}
+
+// List setting groups. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-settings-groups
+func (service *SettingsService) ListGroups() (*[]SettingGroup, *Response, error) {
+  return service.ListGroupsWithContext(context.Background())
+}
+
+// List setting groups with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-settings-groups
+func (service *SettingsService) ListGroupsWithContext(ctx context.Context) (*[]SettingGroup, *Response, error) {
+  _url := "/settings"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  groups := new([]SettingGroup)
+  response, err := service.client.Do(req, groups)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return groups, response, nil
+}
+
+// List the options of a setting group. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-setting-options
+func (service *SettingsService) ListOptions(groupID string) (*[]SettingOption, *Response, error) {
+  return service.ListOptionsWithContext(context.Background(), groupID)
+}
+
+// List the options of a setting group with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-setting-options
+func (service *SettingsService) ListOptionsWithContext(ctx context.Context, groupID string) (*[]SettingOption, *Response, error) {
+  _url := "/settings/" + groupID
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  options := new([]SettingOption)
+  response, err := service.client.Do(req, options)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return options, response, nil
+}
+
+// Get a setting option. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-setting-option
+func (service *SettingsService) GetOption(groupID string, optionID string) (*SettingOption, *Response, error) {
+  return service.GetOptionWithContext(context.Background(), groupID, optionID)
+}
+
+// Get a setting option with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-setting-option
+func (service *SettingsService) GetOptionWithContext(ctx context.Context, groupID string, optionID string) (*SettingOption, *Response, error) {
+  _url := "/settings/" + groupID + "/" + optionID
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  option := new(SettingOption)
+  response, err := service.client.Do(req, option)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return option, response, nil
+}
+
+// Update a setting option. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-setting-option
+func (service *SettingsService) UpdateOption(groupID string, optionID string, option *SettingOptionUpdate) (*SettingOption, *Response, error) {
+  return service.UpdateOptionWithContext(context.Background(), groupID, optionID, option)
+}
+
+// Update a setting option with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-setting-option
+func (service *SettingsService) UpdateOptionWithContext(ctx context.Context, groupID string, optionID string, option *SettingOptionUpdate) (*SettingOption, *Response, error) {
+  _url := "/settings/" + groupID + "/" + optionID
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, option)
+
+  updatedOption := new(SettingOption)
+  response, err := service.client.Do(req, updatedOption)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return updatedOption, response, nil
+}
+
+// Batch update the options of a setting group. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-setting-options
+func (service *SettingsService) BatchOptions(groupID string, opts *BatchSettingOptionUpdate) (*BatchSettingOptionUpdateResponse, *Response, error) {
+  return service.BatchOptionsWithContext(context.Background(), groupID, opts)
+}
+
+// Batch update the options of a setting group with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-setting-options
+func (service *SettingsService) BatchOptionsWithContext(ctx context.Context, groupID string, opts *BatchSettingOptionUpdate) (*BatchSettingOptionUpdateResponse, *Response, error) {
+  _url := "/settings/" + groupID + "/batch"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, opts)
+
+  options := new(BatchSettingOptionUpdateResponse)
+  response, err := service.client.Do(req, options)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return options, response, nil
+}
\ No newline at end of file
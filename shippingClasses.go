@@ -0,0 +1,235 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// Shipping classes service
+type ShippingClassesService service
+
+// ShippingClass object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#product-shipping-class-properties
+type ShippingClass struct {
+  Id                 int         `json:"id,omitempty"`
+  Name               string      `json:"name,omitempty"`
+  Slug               string      `json:"slug,omitempty"`
+  Description        string      `json:"description,omitempty"`
+  Count              int         `json:"count,omitempty"`
+  Links              *Links      `json:"_links,omitempty"`
+}
+
+type ListShippingClassParams struct {
+  Context    string    `url:"context,omitempty"`
+  Page       int       `url:"page,omitempty"`
+  PerPage    int       `url:"per_page,omitempty"`
+  Search     string    `url:"search,omitempty"`
+  Exclude    *[]int    `url:"exclude,omitempty"`
+  Include    *[]int    `url:"include,omitempty"`
+  Offset     int       `url:"offset,omitempty"`
+  Order      string    `url:"order,omitempty"`
+  OrderBy    string    `url:"orderby,omitempty"`
+  HideEmpty  bool      `url:"hide_empty,omitempty"`
+  Product    int       `url:"product,omitempty"`
+  Slug       string    `url:"slug,omitempty"`
+}
+
+type DeleteShippingClassParams struct {
+  Force    bool       `url:"force"`
+}
+
+type BatchShippingClassUpdate struct {
+  Create  *[]ShippingClass `json:"create,omitempty"`
+  Update  *[]ShippingClass `json:"update,omitempty"`
+  Delete  *[]int           `json:"delete,omitempty"`
+}
+
+type BatchShippingClassUpdateResponse struct {
+  Create  *[]ShippingClass `json:"create,omitempty"`
+  Update  *[]ShippingClass `json:"update,omitempty"`
+  Delete  *[]ShippingClass `json:"delete,omitempty"`
+}
+
+// Create a shipping class. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-shipping-class
+func (service *ShippingClassesService) Create(shippingClass *ShippingClass) (*ShippingClass, *Response, error) {
+  return service.CreateWithContext(context.Background(), shippingClass)
+}
+
+// Create a shipping class with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-shipping-class
+func (service *ShippingClassesService) CreateWithContext(ctx context.Context, shippingClass *ShippingClass) (*ShippingClass, *Response, error) {
+  _url := "/products/shipping_classes"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, shippingClass)
+
+  createdShippingClass := new(ShippingClass)
+  response, err := service.client.Do(req, createdShippingClass)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return createdShippingClass, response, nil
+}
+
+// Get a shipping class. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-shipping-class
+func (service *ShippingClassesService) Get(shippingClassID string) (*ShippingClass, *Response, error) {
+  return service.GetWithContext(context.Background(), shippingClassID)
+}
+
+// Get a shipping class with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-shipping-class
+func (service *ShippingClassesService) GetWithContext(ctx context.Context, shippingClassID string) (*ShippingClass, *Response, error) {
+  _url := "/products/shipping_classes/" + shippingClassID
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  shippingClass := new(ShippingClass)
+  response, err := service.client.Do(req, shippingClass)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return shippingClass, response, nil
+}
+
+// List shipping classes. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-shipping-classes
+func (service *ShippingClassesService) List(opts *ListShippingClassParams) (*[]ShippingClass, *Response, error) {
+  return service.ListWithContext(context.Background(), opts)
+}
+
+// List shipping classes with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-shipping-classes
+func (service *ShippingClassesService) ListWithContext(ctx context.Context, opts *ListShippingClassParams) (*[]ShippingClass, *Response, error) {
+  _url := "/products/shipping_classes"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  shippingClasses := new([]ShippingClass)
+  response, err := service.client.Do(req, shippingClasses)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return shippingClasses, response, nil
+}
+
+// Update a shipping class. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-shipping-class
+func (service *ShippingClassesService) Update(shippingClassID string, shippingClass *ShippingClass) (*ShippingClass, *Response, error) {
+  return service.UpdateWithContext(context.Background(), shippingClassID, shippingClass)
+}
+
+// Update a shipping class with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-shipping-class
+func (service *ShippingClassesService) UpdateWithContext(ctx context.Context, shippingClassID string, shippingClass *ShippingClass) (*ShippingClass, *Response, error) {
+  _url := "/products/shipping_classes/" + shippingClassID
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, shippingClass)
+
+  updatedShippingClass := new(ShippingClass)
+  response, err := service.client.Do(req, updatedShippingClass)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return updatedShippingClass, response, nil
+}
+
+// Delete a shipping class. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-shipping-class
+func (service *ShippingClassesService) Delete(shippingClassID string, opts *DeleteShippingClassParams) (*ShippingClass, *Response, error) {
+  return service.DeleteWithContext(context.Background(), shippingClassID, opts)
+}
+
+// Delete a shipping class with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-shipping-class
+func (service *ShippingClassesService) DeleteWithContext(ctx context.Context, shippingClassID string, opts *DeleteShippingClassParams) (*ShippingClass, *Response, error) {
+  _url := "/products/shipping_classes/" + shippingClassID
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
+
+  shippingClass := new(ShippingClass)
+  response, err := service.client.Do(req, shippingClass)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return shippingClass, response, nil
+}
+
+// Batch update shipping classes. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-shipping-classes
+func (service *ShippingClassesService) Batch(opts *BatchShippingClassUpdate) (*BatchShippingClassUpdateResponse, *Response, error) {
+  return service.BatchWithContext(context.Background(), opts)
+}
+
+// Batch update shipping classes with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-shipping-classes
+func (service *ShippingClassesService) BatchWithContext(ctx context.Context, opts *BatchShippingClassUpdate) (*BatchShippingClassUpdateResponse, *Response, error) {
+  _url := "/products/shipping_classes/batch"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, opts)
+
+  shippingClasses := new(BatchShippingClassUpdateResponse)
+  response, err := service.client.Do(req, shippingClasses)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return shippingClasses, response, nil
+}
+
+// Paginate shipping classes, fetching each page as it is iterated
+func (service *ShippingClassesService) Paginate(opts *ListShippingClassParams) *Paginator[ShippingClass] {
+  params := ListShippingClassParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]ShippingClass, *Response, error) {
+    params.Page = page
+    shippingClasses, response, err := service.ListWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *shippingClasses, response, nil
+  })
+}
+
+// List all shipping classes across every page
+func (service *ShippingClassesService) ListAll(ctx context.Context, opts *ListShippingClassParams) ([]ShippingClass, error) {
+  return service.Paginate(opts).Collect(ctx)
+}
+
+// Find a shipping class by name (case-insensitive). Returns nil when no shipping class matches.
+func (service *ShippingClassesService) FindByName(ctx context.Context, name string) (*ShippingClass, error) {
+  shippingClasses, err := service.ListAll(ctx, &ListShippingClassParams{Search: name, PerPage: 100})
+  if err != nil {
+    return nil, err
+  }
+
+  return findTerm(shippingClasses, func(term ShippingClass) bool {
+    return matchTermName(term.Name, name)
+  }), nil
+}
+
+// Find a shipping class by slug. Returns nil when no shipping class matches.
+func (service *ShippingClassesService) FindBySlug(ctx context.Context, slug string) (*ShippingClass, error) {
+  shippingClasses, _, err := service.ListWithContext(ctx, &ListShippingClassParams{Slug: slug})
+  if err != nil {
+    return nil, err
+  }
+
+  return findTerm(*shippingClasses, func(term ShippingClass) bool {
+    return term.Slug == slug
+  }), nil
+}
+
+// Ensure a shipping class exists, finding it by name or creating it
+func (service *ShippingClassesService) Ensure(ctx context.Context, name string) (*ShippingClass, error) {
+  return ensureTerm(ctx,
+    func(ctx context.Context) (*ShippingClass, error) {
+      return service.FindByName(ctx, name)
+    },
+    func(ctx context.Context) (*ShippingClass, error) {
+      created, _, err := service.CreateWithContext(ctx, &ShippingClass{Name: name})
+      return created, err
+    },
+    func(ctx context.Context, termID string) (*ShippingClass, error) {
+      existing, _, err := service.GetWithContext(ctx, termID)
+      return existing, err
+    },
+  )
+}
\ No newline at end of file
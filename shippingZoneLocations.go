@@ -0,0 +1,63 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// Shipping zone locations service
+type ShippingZoneLocationsService service
+
+// Shipping zone location types
+const (
+  ShippingZoneLocationPostcode  = "postcode"
+  ShippingZoneLocationState     = "state"
+  ShippingZoneLocationCountry   = "country"
+  ShippingZoneLocationContinent = "continent"
+)
+
+// ShippingZoneLocation object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#shipping-zone-locations
+type ShippingZoneLocation struct {
+  Code   string    `json:"code,omitempty"`
+  Type   string    `json:"type,omitempty"`
+  Links  *Links    `json:"_links,omitempty"`
+}
+
+// List the locations of a shipping zone. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-locations-of-a-shipping-zone
+func (service *ShippingZoneLocationsService) List(zoneID string) (*[]ShippingZoneLocation, *Response, error) {
+  return service.ListWithContext(context.Background(), zoneID)
+}
+
+// List the locations of a shipping zone with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-locations-of-a-shipping-zone
+func (service *ShippingZoneLocationsService) ListWithContext(ctx context.Context, zoneID string) (*[]ShippingZoneLocation, *Response, error) {
+  _url := "/shipping/zones/" + zoneID + "/locations"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  locations := new([]ShippingZoneLocation)
+  response, err := service.client.Do(req, locations)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return locations, response, nil
+}
+
+// Replace the locations of a shipping zone. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-locations-of-a-shipping-zone
+func (service *ShippingZoneLocationsService) Update(zoneID string, locations *[]ShippingZoneLocation) (*[]ShippingZoneLocation, *Response, error) {
+  return service.UpdateWithContext(context.Background(), zoneID, locations)
+}
+
+// Replace the locations of a shipping zone with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-locations-of-a-shipping-zone
+func (service *ShippingZoneLocationsService) UpdateWithContext(ctx context.Context, zoneID string, locations *[]ShippingZoneLocation) (*[]ShippingZoneLocation, *Response, error) {
+  _url := "/shipping/zones/" + zoneID + "/locations"
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, locations)
+
+  updatedLocations := new([]ShippingZoneLocation)
+  response, err := service.client.Do(req, updatedLocations)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return updatedLocations, response, nil
+}
\ No newline at end of file
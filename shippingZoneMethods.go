@@ -0,0 +1,155 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// Shipping zone methods service
+type ShippingZoneMethodsService service
+
+// ShippingZoneMethod object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#shipping-zone-method-properties
+type ShippingZoneMethod struct {
+  InstanceId         int                                  `json:"instance_id,omitempty"`
+  Title              string                               `json:"title,omitempty"`
+  Order              int                                  `json:"order,omitempty"`
+  Enabled            bool                                 `json:"enabled,omitempty"`
+  MethodId           string                               `json:"method_id,omitempty"`
+  MethodTitle        string                               `json:"method_title,omitempty"`
+  MethodDescription  string                               `json:"method_description,omitempty"`
+  Settings           map[string]ShippingZoneMethodSetting `json:"settings,omitempty"`
+  Links              *Links                               `json:"_links,omitempty"`
+}
+
+// ShippingZoneMethodSetting object. Value and Default are usually strings, but multiselect settings
+// (eg. from plugin shipping methods) hold a list of strings. Options map values to labels, or group
+// names to nested value/label maps.
+// Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#shipping-method-settings-properties
+type ShippingZoneMethodSetting struct {
+  Id           string                  `json:"id,omitempty"`
+  Label        string                  `json:"label,omitempty"`
+  Description  string                  `json:"description,omitempty"`
+  Type         string                  `json:"type,omitempty"`
+  Value        interface{}             `json:"value,omitempty"`
+  Default      interface{}             `json:"default,omitempty"`
+  Tip          string                  `json:"tip,omitempty"`
+  Placeholder  string                  `json:"placeholder,omitempty"`
+  Options      map[string]interface{}  `json:"options,omitempty"`
+}
+
+// ShippingZoneMethodRequest is the body to create or update a shipping zone method. Settings are
+// sent as values keyed by setting ID (eg. "cost", or a []string for a multiselect setting), and
+// Enabled is only sent when set.
+type ShippingZoneMethodRequest struct {
+  MethodId  string                  `json:"method_id,omitempty"`
+  Order     int                     `json:"order,omitempty"`
+  Enabled   *bool                   `json:"enabled,omitempty"`
+  Settings  map[string]interface{}  `json:"settings,omitempty"`
+}
+
+type ListShippingZoneMethodsParams struct {
+  Context  string    `url:"context,omitempty"`
+}
+
+type DeleteShippingZoneMethodParams struct {
+  Force    bool       `url:"force"`
+}
+
+// Add a method to a shipping zone. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#include-a-shipping-method-to-a-shipping-zone
+func (service *ShippingZoneMethodsService) Create(zoneID string, method *ShippingZoneMethodRequest) (*ShippingZoneMethod, *Response, error) {
+  return service.CreateWithContext(context.Background(), zoneID, method)
+}
+
+// Add a method to a shipping zone with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#include-a-shipping-method-to-a-shipping-zone
+func (service *ShippingZoneMethodsService) CreateWithContext(ctx context.Context, zoneID string, method *ShippingZoneMethodRequest) (*ShippingZoneMethod, *Response, error) {
+  _url := "/shipping/zones/" + zoneID + "/methods"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, method)
+
+  createdMethod := new(ShippingZoneMethod)
+  response, err := service.client.Do(req, createdMethod)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return createdMethod, response, nil
+}
+
+// Get a method of a shipping zone. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-shipping-method-from-a-shipping-zone
+func (service *ShippingZoneMethodsService) Get(zoneID string, instanceID string) (*ShippingZoneMethod, *Response, error) {
+  return service.GetWithContext(context.Background(), zoneID, instanceID)
+}
+
+// Get a method of a shipping zone with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-shipping-method-from-a-shipping-zone
+func (service *ShippingZoneMethodsService) GetWithContext(ctx context.Context, zoneID string, instanceID string) (*ShippingZoneMethod, *Response, error) {
+  _url := "/shipping/zones/" + zoneID + "/methods/" + instanceID
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  method := new(ShippingZoneMethod)
+  response, err := service.client.Do(req, method)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return method, response, nil
+}
+
+// List the methods of a shipping zone. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-shipping-methods-from-a-shipping-zone
+func (service *ShippingZoneMethodsService) List(zoneID string, opts *ListShippingZoneMethodsParams) (*[]ShippingZoneMethod, *Response, error) {
+  return service.ListWithContext(context.Background(), zoneID, opts)
+}
+
+// List the methods of a shipping zone with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-shipping-methods-from-a-shipping-zone
+func (service *ShippingZoneMethodsService) ListWithContext(ctx context.Context, zoneID string, opts *ListShippingZoneMethodsParams) (*[]ShippingZoneMethod, *Response, error) {
+  _url := "/shipping/zones/" + zoneID + "/methods"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  methods := new([]ShippingZoneMethod)
+  response, err := service.client.Do(req, methods)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return methods, response, nil
+}
+
+// Update a method of a shipping zone. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-shipping-method-of-a-shipping-zone
+func (service *ShippingZoneMethodsService) Update(zoneID string, instanceID string, method *ShippingZoneMethodRequest) (*ShippingZoneMethod, *Response, error) {
+  return service.UpdateWithContext(context.Background(), zoneID, instanceID, method)
+}
+
+// Update a method of a shipping zone with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-shipping-method-of-a-shipping-zone
+func (service *ShippingZoneMethodsService) UpdateWithContext(ctx context.Context, zoneID string, instanceID string, method *ShippingZoneMethodRequest) (*ShippingZoneMethod, *Response, error) {
+  _url := "/shipping/zones/" + zoneID + "/methods/" + instanceID
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, method)
+
+  updatedMethod := new(ShippingZoneMethod)
+  response, err := service.client.Do(req, updatedMethod)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return updatedMethod, response, nil
+}
+
+// Delete a method from a shipping zone. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-shipping-method-from-a-shipping-zone
+func (service *ShippingZoneMethodsService) Delete(zoneID string, instanceID string, opts *DeleteShippingZoneMethodParams) (*ShippingZoneMethod, *Response, error) {
+  return service.DeleteWithContext(context.Background(), zoneID, instanceID, opts)
+}
+
+// Delete a method from a shipping zone with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-shipping-method-from-a-shipping-zone
+func (service *ShippingZoneMethodsService) DeleteWithContext(ctx context.Context, zoneID string, instanceID string, opts *DeleteShippingZoneMethodParams) (*ShippingZoneMethod, *Response, error) {
+  _url := "/shipping/zones/" + zoneID + "/methods/" + instanceID
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
+
+  method := new(ShippingZoneMethod)
+  response, err := service.client.Do(req, method)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return method, response, nil
+}
\ No newline at end of file
@@ -0,0 +1,124 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// Shipping zones service
+type ShippingZonesService service
+
+// ShippingZone object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#shipping-zone-properties
+type ShippingZone struct {
+  Id     int       `json:"id,omitempty"`
+  Name   string    `json:"name,omitempty"`
+  Order  int       `json:"order"`
+  Links  *Links    `json:"_links,omitempty"`
+}
+
+type ListShippingZonesParams struct {
+  Context  string    `url:"context,omitempty"`
+}
+
+type DeleteShippingZoneParams struct {
+  Force    bool       `url:"force"`
+}
+
+// Create a shipping zone. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-shipping-zone
+func (service *ShippingZonesService) Create(zone *ShippingZone) (*ShippingZone, *Response, error) {
+  return service.CreateWithContext(context.Background(), zone)
+}
+
+// Create a shipping zone with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-shipping-zone
+func (service *ShippingZonesService) CreateWithContext(ctx context.Context, zone *ShippingZone) (*ShippingZone, *Response, error) {
+  _url := "/shipping/zones"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, zone)
+
+  createdZone := new(ShippingZone)
+  response, err := service.client.Do(req, createdZone)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return createdZone, response, nil
+}
+
+// Get a shipping zone. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-shipping-zone
+func (service *ShippingZonesService) Get(zoneID string) (*ShippingZone, *Response, error) {
+  return service.GetWithContext(context.Background(), zoneID)
+}
+
+// Get a shipping zone with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-shipping-zone
+func (service *ShippingZonesService) GetWithContext(ctx context.Context, zoneID string) (*ShippingZone, *Response, error) {
+  _url := "/shipping/zones/" + zoneID
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  zone := new(ShippingZone)
+  response, err := service.client.Do(req, zone)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return zone, response, nil
+}
+
+// List shipping zones. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-shipping-zones
+func (service *ShippingZonesService) List(opts *ListShippingZonesParams) (*[]ShippingZone, *Response, error) {
+  return service.ListWithContext(context.Background(), opts)
+}
+
+// List shipping zones with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-shipping-zones
+func (service *ShippingZonesService) ListWithContext(ctx context.Context, opts *ListShippingZonesParams) (*[]ShippingZone, *Response, error) {
+  _url := "/shipping/zones"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  zones := new([]ShippingZone)
+  response, err := service.client.Do(req, zones)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return zones, response, nil
+}
+
+// Update a shipping zone. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-shipping-zone
+func (service *ShippingZonesService) Update(zoneID string, zone *ShippingZone) (*ShippingZone, *Response, error) {
+  return service.UpdateWithContext(context.Background(), zoneID, zone)
+}
+
+// Update a shipping zone with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-shipping-zone
+func (service *ShippingZonesService) UpdateWithContext(ctx context.Context, zoneID string, zone *ShippingZone) (*ShippingZone, *Response, error) {
+  _url := "/shipping/zones/" + zoneID
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, zone)
+
+  updatedZone := new(ShippingZone)
+  response, err := service.client.Do(req, updatedZone)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return updatedZone, response, nil
+}
+
+// Delete a shipping zone. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-shipping-zone
+func (service *ShippingZonesService) Delete(zoneID string, opts *DeleteShippingZoneParams) (*ShippingZone, *Response, error) {
+  return service.DeleteWithContext(context.Background(), zoneID, opts)
+}
+
+// Delete a shipping zone with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-shipping-zone
+func (service *ShippingZonesService) DeleteWithContext(ctx context.Context, zoneID string, opts *DeleteShippingZoneParams) (*ShippingZone, *Response, error) {
+  _url := "/shipping/zones/" + zoneID
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
+
+  zone := new(ShippingZone)
+  response, err := service.client.Do(req, zone)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return zone, response, nil
+}
\ No newline at end of file
@@ -0,0 +1,254 @@
+package woocommerce
+
+import (
+  "bytes"
+  "context"
+  "encoding/json"
+  "slices"
+  "strconv"
+  "strings"
+)
+
+// ShippingZoneConfig is the desired configuration of a shipping zone, applied with ShippingZonesService.Apply.
+// The zone is matched by name (case-insensitive). Locations and methods are replaced by the configured ones,
+// and methods are ordered as listed.
+type ShippingZoneConfig struct {
+  Name      string
+  Order     int
+  Locations []ShippingZoneLocation
+  Methods   []ShippingZoneMethodConfig
+}
+
+// ShippingZoneMethodConfig is the desired configuration of a shipping zone method (eg. "flat_rate"). Only the
+// given settings are compared and updated, other settings keep their current values. Setting values are
+// strings, or a []string for multiselect settings.
+type ShippingZoneMethodConfig struct {
+  MethodId string
+  Enabled  bool
+  Settings map[string]interface{}
+}
+
+// ShippingZoneDiff lists the changes needed to apply a shipping zone configuration
+type ShippingZoneDiff struct {
+  // Zone is the existing zone, nil when it has to be created
+  Zone       *ShippingZone
+  CreateZone bool
+  UpdateZone bool
+
+  // Locations replacing the current ones, nil when they are unchanged
+  Locations *[]ShippingZoneLocation
+
+  CreateMethods []ShippingZoneMethodRequest
+  UpdateMethods map[int]ShippingZoneMethodRequest
+  DeleteMethods []ShippingZoneMethod
+}
+
+// Empty reports whether the zone already matches its configuration
+func (diff *ShippingZoneDiff) Empty() bool {
+  return !diff.CreateZone && !diff.UpdateZone && diff.Locations == nil &&
+    len(diff.CreateMethods) == 0 && len(diff.UpdateMethods) == 0 && len(diff.DeleteMethods) == 0
+}
+
+// Diff compares the configuration with the current shipping zone, without changing anything
+func (service *ShippingZonesService) Diff(ctx context.Context, config *ShippingZoneConfig) (*ShippingZoneDiff, error) {
+  diff := &ShippingZoneDiff{UpdateMethods: map[int]ShippingZoneMethodRequest{}}
+
+  zones, _, err := service.ListWithContext(ctx, nil)
+  if err != nil {
+    return nil, err
+  }
+
+  diff.Zone = findTerm(*zones, func(zone ShippingZone) bool {
+    return matchTermName(zone.Name, config.Name)
+  })
+
+  // New zone? (every location and method is created)
+  if diff.Zone == nil {
+    diff.CreateZone = true
+
+    if len(config.Locations) > 0 {
+      diff.Locations = &config.Locations
+    }
+
+    for i, method := range config.Methods {
+      diff.CreateMethods = append(diff.CreateMethods, shippingZoneMethodRequest(method, i+1))
+    }
+
+    return diff, nil
+  }
+
+  zoneID := strconv.Itoa(diff.Zone.Id)
+  diff.UpdateZone = diff.Zone.Order != config.Order
+
+  locations, _, err := service.client.ShippingZoneLocations.ListWithContext(ctx, zoneID)
+  if err != nil {
+    return nil, err
+  }
+
+  if !sameShippingZoneLocations(*locations, config.Locations) {
+    diff.Locations = &config.Locations
+  }
+
+  methods, _, err := service.client.ShippingZoneMethods.ListWithContext(ctx, zoneID, nil)
+  if err != nil {
+    return nil, err
+  }
+
+  remaining := slices.Clone(*methods)
+
+  for i, method := range config.Methods {
+    // Match the first remaining method of the same type
+    index := slices.IndexFunc(remaining, func(existing ShippingZoneMethod) bool {
+      return existing.MethodId == method.MethodId
+    })
+
+    if index < 0 {
+      diff.CreateMethods = append(diff.CreateMethods, shippingZoneMethodRequest(method, i+1))
+
+      continue
+    }
+
+    existing := remaining[index]
+    remaining = slices.Delete(remaining, index, index+1)
+
+    if !shippingZoneMethodMatches(existing, method, i+1) {
+      update := shippingZoneMethodRequest(method, i+1)
+      update.MethodId = ""
+
+      diff.UpdateMethods[existing.InstanceId] = update
+    }
+  }
+
+  diff.DeleteMethods = remaining
+
+  return diff, nil
+}
+
+// Apply creates or updates the shipping zone, its locations and its methods to match the configuration.
+// It returns the applied changes.
+func (service *ShippingZonesService) Apply(ctx context.Context, config *ShippingZoneConfig) (*ShippingZoneDiff, error) {
+  diff, err := service.Diff(ctx, config)
+  if err != nil {
+    return nil, err
+  }
+
+  if diff.CreateZone {
+    createdZone, _, err := service.CreateWithContext(ctx, &ShippingZone{Name: config.Name, Order: config.Order})
+    if err != nil {
+      return diff, err
+    }
+
+    diff.Zone = createdZone
+  } else if diff.UpdateZone {
+    updatedZone, _, err := service.UpdateWithContext(ctx, strconv.Itoa(diff.Zone.Id), &ShippingZone{Order: config.Order})
+    if err != nil {
+      return diff, err
+    }
+
+    diff.Zone = updatedZone
+  }
+
+  zoneID := strconv.Itoa(diff.Zone.Id)
+
+  if diff.Locations != nil {
+    locations := make([]ShippingZoneLocation, 0, len(*diff.Locations))
+
+    for _, location := range *diff.Locations {
+      locations = append(locations, ShippingZoneLocation{Code: location.Code, Type: location.Type})
+    }
+
+    if _, _, err := service.client.ShippingZoneLocations.UpdateWithContext(ctx, zoneID, &locations); err != nil {
+      return diff, err
+    }
+  }
+
+  for _, method := range diff.DeleteMethods {
+    _, _, err := service.client.ShippingZoneMethods.DeleteWithContext(ctx, zoneID, strconv.Itoa(method.InstanceId), &DeleteShippingZoneMethodParams{Force: true})
+    if err != nil {
+      return diff, err
+    }
+  }
+
+  for instanceID, method := range diff.UpdateMethods {
+    if _, _, err := service.client.ShippingZoneMethods.UpdateWithContext(ctx, zoneID, strconv.Itoa(instanceID), &method); err != nil {
+      return diff, err
+    }
+  }
+
+  for _, method := range diff.CreateMethods {
+    if _, _, err := service.client.ShippingZoneMethods.CreateWithContext(ctx, zoneID, &method); err != nil {
+      return diff, err
+    }
+  }
+
+  return diff, nil
+}
+
+// shippingZoneMethodRequest returns the request to create or update a method to match its configuration
+func shippingZoneMethodRequest(method ShippingZoneMethodConfig, order int) ShippingZoneMethodRequest {
+  enabled := method.Enabled
+
+  return ShippingZoneMethodRequest{
+    MethodId: method.MethodId,
+    Order:    order,
+    Enabled:  &enabled,
+    Settings: method.Settings,
+  }
+}
+
+// shippingZoneMethodMatches reports whether the existing method matches its configuration
+func shippingZoneMethodMatches(existing ShippingZoneMethod, method ShippingZoneMethodConfig, order int) bool {
+  if existing.Enabled != method.Enabled || existing.Order != order {
+    return false
+  }
+
+  for settingID, value := range method.Settings {
+    setting, ok := existing.Settings[settingID]
+
+    if !ok || !sameShippingZoneSettingValue(setting.Value, value) {
+      return false
+    }
+  }
+
+  return true
+}
+
+// sameShippingZoneSettingValue compares setting values by their JSON encoding, so that a configured
+// []string matches the list decoded from the store
+func sameShippingZoneSettingValue(current interface{}, desired interface{}) bool {
+  currentJSON, err := json.Marshal(current)
+  if err != nil {
+    return false
+  }
+
+  desiredJSON, err := json.Marshal(desired)
+  if err != nil {
+    return false
+  }
+
+  return bytes.Equal(currentJSON, desiredJSON)
+}
+
+// sameShippingZoneLocations reports whether both lists hold the same locations, in any order
+func sameShippingZoneLocations(current []ShippingZoneLocation, desired []ShippingZoneLocation) bool {
+  if len(current) != len(desired) {
+    return false
+  }
+
+  key := func(location ShippingZoneLocation) string {
+    return location.Type + ":" + strings.ToUpper(location.Code)
+  }
+
+  currentKeys := make([]string, 0, len(current))
+  desiredKeys := make([]string, 0, len(desired))
+
+  for i := range current {
+    currentKeys = append(currentKeys, key(current[i]))
+    desiredKeys = append(desiredKeys, key(desired[i]))
+  }
+
+  slices.Sort(currentKeys)
+  slices.Sort(desiredKeys)
+
+  return slices.Equal(currentKeys, desiredKeys)
+}
@@ -0,0 +1,154 @@
+package woocommerce
+
+import (
+  "context"
+  "errors"
+  "sync"
+)
+
+// Store API session headers. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-tokens.md
+const (
+  headerCartToken   = "Cart-Token"
+  headerNonce       = "Nonce"
+  headerLegacyNonce = "X-WC-Store-API-Nonce"
+)
+
+// Store API nonce error codes, returned when the nonce is missing or expired
+const (
+  errorCodeMissingNonce = "woocommerce_rest_missing_nonce"
+  errorCodeInvalidNonce = "woocommerce_rest_invalid_nonce"
+)
+
+// StoreAPI is a client for the public WooCommerce Store API (wc/store/v1), used by storefronts to manage
+// a customer cart and checkout. It keeps the cart session, sending back the Cart-Token and Nonce
+// headers returned by the store. A StoreAPI holds a single cart session, create one per customer.
+// Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/README.md
+type StoreAPI struct {
+  client *Client
+
+  mutex     sync.Mutex
+  cartToken string
+  nonce     string
+
+  Cart        *StoreCartService
+  CartItems   *StoreCartItemsService
+  CartCoupons *StoreCartCouponsService
+  Checkout    *StoreCheckoutService
+}
+
+type storeService struct {
+  api *StoreAPI
+}
+
+// NewStoreAPI creates a Store API client for the given store URL. Options are the ones of the REST API
+// client, the namespace is always wc/store/v1. No authenticator is needed for guest carts.
+func NewStoreAPI(shopURL string, opts ...ClientOption) (*StoreAPI, error) {
+  client, err := New(shopURL, append(append([]ClientOption{}, opts...), WithAPIVersion(NamespaceStoreV1))...)
+  if err != nil {
+    return nil, err
+  }
+
+  api := &StoreAPI{client: client}
+
+  api.Cart = &StoreCartService{api: api}
+  api.CartItems = &StoreCartItemsService{api: api}
+  api.CartCoupons = &StoreCartCouponsService{api: api}
+  api.Checkout = &StoreCheckoutService{api: api}
+
+  return api, nil
+}
+
+// CartToken returns the token of the current cart session, empty until the store returns one
+func (api *StoreAPI) CartToken() string {
+  api.mutex.Lock()
+  defer api.mutex.Unlock()
+
+  return api.cartToken
+}
+
+// SetCartToken resumes a cart session (eg. a token stored in the storefront session)
+func (api *StoreAPI) SetCartToken(cartToken string) {
+  api.mutex.Lock()
+  defer api.mutex.Unlock()
+
+  api.cartToken = cartToken
+}
+
+// Nonce returns the current nonce, empty until the store returns one
+func (api *StoreAPI) Nonce() string {
+  api.mutex.Lock()
+  defer api.mutex.Unlock()
+
+  return api.nonce
+}
+
+// SetNonce sets the nonce sent with requests that change the cart
+func (api *StoreAPI) SetNonce(nonce string) {
+  api.mutex.Lock()
+  defer api.mutex.Unlock()
+
+  api.nonce = nonce
+}
+
+// do sends a Store API request with the session headers, and keeps the session headers of the
+// response. Requests rejected for a missing or expired nonce are sent once more with the new nonce.
+func (api *StoreAPI) do(ctx context.Context, method string, urlStr string, body interface{}, v interface{}) (*Response, error) {
+  response, sentNonce, err := api.send(ctx, method, urlStr, body, v)
+
+  var apiError *APIError
+
+  if errors.As(err, &apiError) && (apiError.Code == errorCodeMissingNonce || apiError.Code == errorCodeInvalidNonce) {
+    if nonce := api.Nonce(); nonce != "" && nonce != sentNonce {
+      response, _, err = api.send(ctx, method, urlStr, body, v)
+    }
+  }
+
+  return response, err
+}
+
+// send sends a single request, returning the nonce it was sent with
+func (api *StoreAPI) send(ctx context.Context, method string, urlStr string, body interface{}, v interface{}) (*Response, string, error) {
+  req, err := api.client.NewRequestWithContext(ctx, method, urlStr, nil, body)
+  if err != nil {
+    return nil, "", err
+  }
+
+  api.mutex.Lock()
+  cartToken, nonce := api.cartToken, api.nonce
+  api.mutex.Unlock()
+
+  if cartToken != "" {
+    req.Header.Set(headerCartToken, cartToken)
+  }
+
+  if nonce != "" {
+    req.Header.Set(headerNonce, nonce)
+  }
+
+  response, err := api.client.Do(req, v)
+
+  if response != nil {
+    api.readSessionHeaders(response)
+  }
+
+  return response, nonce, err
+}
+
+// readSessionHeaders keeps the cart token and nonce returned by the store
+func (api *StoreAPI) readSessionHeaders(response *Response) {
+  api.mutex.Lock()
+  defer api.mutex.Unlock()
+
+  if cartToken := response.Header.Get(headerCartToken); cartToken != "" {
+    api.cartToken = cartToken
+  }
+
+  nonce := response.Header.Get(headerNonce)
+  if nonce == "" {
+    nonce = response.Header.Get(headerLegacyNonce)
+  }
+
+  if nonce != "" {
+    api.nonce = nonce
+  }
+}
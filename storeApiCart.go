@@ -0,0 +1,543 @@
+package woocommerce
+
+import (
+  "context"
+  "net/url"
+)
+
+// Store API cart service
+type StoreCartService storeService
+
+// Store API cart items service
+type StoreCartItemsService storeService
+
+// Store API cart coupons service
+type StoreCartCouponsService storeService
+
+// StoreCurrency is the currency of Store API prices. Amounts are strings in the currency minor unit (eg. "1000" is 10.00).
+type StoreCurrency struct {
+  CurrencyCode               string    `json:"currency_code,omitempty"`
+  CurrencySymbol             string    `json:"currency_symbol,omitempty"`
+  CurrencyMinorUnit          int       `json:"currency_minor_unit,omitempty"`
+  CurrencyDecimalSeparator   string    `json:"currency_decimal_separator,omitempty"`
+  CurrencyThousandSeparator  string    `json:"currency_thousand_separator,omitempty"`
+  CurrencyPrefix             string    `json:"currency_prefix,omitempty"`
+  CurrencySuffix             string    `json:"currency_suffix,omitempty"`
+}
+
+// StoreCart object. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md
+type StoreCart struct {
+  Items                  []StoreCartItem                `json:"items,omitempty"`
+  Coupons                []StoreCartCoupon              `json:"coupons,omitempty"`
+  Fees                   []StoreCartFee                 `json:"fees,omitempty"`
+  Totals                 *StoreCartTotals               `json:"totals,omitempty"`
+  ShippingAddress        *Shipping                      `json:"shipping_address,omitempty"`
+  BillingAddress         *Billing                       `json:"billing_address,omitempty"`
+  NeedsPayment           bool                           `json:"needs_payment,omitempty"`
+  NeedsShipping          bool                           `json:"needs_shipping,omitempty"`
+  PaymentRequirements    []string                       `json:"payment_requirements,omitempty"`
+  HasCalculatedShipping  bool                           `json:"has_calculated_shipping,omitempty"`
+  ShippingRates          []StoreShippingPackage         `json:"shipping_rates,omitempty"`
+  ItemsCount             int                            `json:"items_count,omitempty"`
+  ItemsWeight            float64                        `json:"items_weight,omitempty"`
+  PaymentMethods         []string                       `json:"payment_methods,omitempty"`
+  Errors                 []StoreCartError               `json:"errors,omitempty"`
+  Extensions             map[string]interface{}         `json:"extensions,omitempty"`
+}
+
+// StoreCartItem object. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-items.md
+type StoreCartItem struct {
+  Key                 string                   `json:"key,omitempty"`
+  Id                  int                      `json:"id,omitempty"`
+  Type                string                   `json:"type,omitempty"`
+  Quantity            int                      `json:"quantity,omitempty"`
+  QuantityLimits      *StoreQuantityLimits     `json:"quantity_limits,omitempty"`
+  Name                string                   `json:"name,omitempty"`
+  ShortDescription    string                   `json:"short_description,omitempty"`
+  Description         string                   `json:"description,omitempty"`
+  Sku                 string                   `json:"sku,omitempty"`
+  LowStockRemaining   int                      `json:"low_stock_remaining,omitempty"`
+  BackordersAllowed   bool                     `json:"backorders_allowed,omitempty"`
+  ShowBackorderBadge  bool                     `json:"show_backorder_badge,omitempty"`
+  SoldIndividually    bool                     `json:"sold_individually,omitempty"`
+  Permalink           string                   `json:"permalink,omitempty"`
+  Images              []StoreImage             `json:"images,omitempty"`
+  Variation           []StoreItemVariation     `json:"variation,omitempty"`
+  Prices              *StorePrices             `json:"prices,omitempty"`
+  Totals              *StoreCartItemTotals     `json:"totals,omitempty"`
+  CatalogVisibility   string                   `json:"catalog_visibility,omitempty"`
+  Extensions          map[string]interface{}   `json:"extensions,omitempty"`
+}
+
+type StoreQuantityLimits struct {
+  Minimum     int   `json:"minimum,omitempty"`
+  Maximum     int   `json:"maximum,omitempty"`
+  MultipleOf  int   `json:"multiple_of,omitempty"`
+  Editable    bool  `json:"editable,omitempty"`
+}
+
+type StoreImage struct {
+  Id         int     `json:"id,omitempty"`
+  Src        string  `json:"src,omitempty"`
+  Thumbnail  string  `json:"thumbnail,omitempty"`
+  Srcset     string  `json:"srcset,omitempty"`
+  Sizes      string  `json:"sizes,omitempty"`
+  Name       string  `json:"name,omitempty"`
+  Alt        string  `json:"alt,omitempty"`
+}
+
+// StoreItemVariation is a variation attribute of a cart item (eg. "Color": "Blue")
+type StoreItemVariation struct {
+  Attribute  string  `json:"attribute,omitempty"`
+  Value      string  `json:"value,omitempty"`
+}
+
+type StorePrices struct {
+  StoreCurrency
+  Price         string           `json:"price,omitempty"`
+  RegularPrice  string           `json:"regular_price,omitempty"`
+  SalePrice     string           `json:"sale_price,omitempty"`
+  PriceRange    *StorePriceRange `json:"price_range,omitempty"`
+}
+
+type StorePriceRange struct {
+  MinAmount  string  `json:"min_amount,omitempty"`
+  MaxAmount  string  `json:"max_amount,omitempty"`
+}
+
+type StoreCartItemTotals struct {
+  StoreCurrency
+  LineSubtotal     string  `json:"line_subtotal,omitempty"`
+  LineSubtotalTax  string  `json:"line_subtotal_tax,omitempty"`
+  LineTotal        string  `json:"line_total,omitempty"`
+  LineTotalTax     string  `json:"line_total_tax,omitempty"`
+}
+
+type StoreCartTotals struct {
+  StoreCurrency
+  TotalItems        string          `json:"total_items,omitempty"`
+  TotalItemsTax     string          `json:"total_items_tax,omitempty"`
+  TotalFees         string          `json:"total_fees,omitempty"`
+  TotalFeesTax      string          `json:"total_fees_tax,omitempty"`
+  TotalDiscount     string          `json:"total_discount,omitempty"`
+  TotalDiscountTax  string          `json:"total_discount_tax,omitempty"`
+  TotalShipping     string          `json:"total_shipping,omitempty"`
+  TotalShippingTax  string          `json:"total_shipping_tax,omitempty"`
+  TotalPrice        string          `json:"total_price,omitempty"`
+  TotalTax          string          `json:"total_tax,omitempty"`
+  TaxLines          []StoreTaxLine  `json:"tax_lines,omitempty"`
+}
+
+type StoreTaxLine struct {
+  Name   string  `json:"name,omitempty"`
+  Price  string  `json:"price,omitempty"`
+  Rate   string  `json:"rate,omitempty"`
+}
+
+// StoreCartCoupon object. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-coupons.md
+type StoreCartCoupon struct {
+  Code          string                 `json:"code,omitempty"`
+  DiscountType  string                 `json:"discount_type,omitempty"`
+  Totals        *StoreCartCouponTotals `json:"totals,omitempty"`
+}
+
+type StoreCartCouponTotals struct {
+  StoreCurrency
+  TotalDiscount     string  `json:"total_discount,omitempty"`
+  TotalDiscountTax  string  `json:"total_discount_tax,omitempty"`
+}
+
+type StoreCartFee struct {
+  Key     string                `json:"key,omitempty"`
+  Name    string                `json:"name,omitempty"`
+  Totals  *StoreCartFeeTotals   `json:"totals,omitempty"`
+}
+
+type StoreCartFeeTotals struct {
+  StoreCurrency
+  Total     string  `json:"total,omitempty"`
+  TotalTax  string  `json:"total_tax,omitempty"`
+}
+
+// StoreShippingPackage is a package of cart items, with the shipping rates available for it
+type StoreShippingPackage struct {
+  PackageId      int                        `json:"package_id,omitempty"`
+  Name           string                     `json:"name,omitempty"`
+  Destination    *Shipping                  `json:"destination,omitempty"`
+  Items          []StoreShippingPackageItem `json:"items,omitempty"`
+  ShippingRates  []StoreShippingRate        `json:"shipping_rates,omitempty"`
+}
+
+type StoreShippingPackageItem struct {
+  Key       string  `json:"key,omitempty"`
+  Name      string  `json:"name,omitempty"`
+  Quantity  int     `json:"quantity,omitempty"`
+}
+
+type StoreShippingRate struct {
+  StoreCurrency
+  RateId        string      `json:"rate_id,omitempty"`
+  Name          string      `json:"name,omitempty"`
+  Description   string      `json:"description,omitempty"`
+  DeliveryTime  string      `json:"delivery_time,omitempty"`
+  Price         string      `json:"price,omitempty"`
+  Taxes         string      `json:"taxes,omitempty"`
+  InstanceId    int         `json:"instance_id,omitempty"`
+  MethodId      string      `json:"method_id,omitempty"`
+  MetaData      []MetaData  `json:"meta_data,omitempty"`
+  Selected      bool        `json:"selected,omitempty"`
+}
+
+// StoreCartError is a problem with the cart (eg. an item out of stock)
+type StoreCartError struct {
+  Code     string  `json:"code,omitempty"`
+  Message  string  `json:"message,omitempty"`
+}
+
+// StoreCartItemRequest is the body to add a cart item, or update its quantity
+type StoreCartItemRequest struct {
+  Key        string                `json:"key,omitempty"`
+  Id         int                   `json:"id,omitempty"`
+  Quantity   int                   `json:"quantity,omitempty"`
+  Variation  []StoreItemVariation  `json:"variation,omitempty"`
+}
+
+// StoreCartCustomerRequest is the body to update the cart customer addresses
+type StoreCartCustomerRequest struct {
+  BillingAddress   *Billing   `json:"billing_address,omitempty"`
+  ShippingAddress  *Shipping  `json:"shipping_address,omitempty"`
+}
+
+// StoreCartCouponRequest is the body to apply or remove a coupon
+type StoreCartCouponRequest struct {
+  Code  string  `json:"code"`
+}
+
+// StoreShippingRateRequest is the body to select the shipping rate of a package
+type StoreShippingRateRequest struct {
+  PackageId  int     `json:"package_id"`
+  RateId     string  `json:"rate_id"`
+}
+
+// Get the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md
+func (service *StoreCartService) Get() (*StoreCart, *Response, error) {
+  return service.GetWithContext(context.Background())
+}
+
+// Get the cart with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md
+func (service *StoreCartService) GetWithContext(ctx context.Context) (*StoreCart, *Response, error) {
+  cart := new(StoreCart)
+  response, err := service.api.do(ctx, "GET", "/cart", nil, cart)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return cart, response, nil
+}
+
+// Add an item to the cart, returning the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md
+func (service *StoreCartService) AddItem(item *StoreCartItemRequest) (*StoreCart, *Response, error) {
+  return service.AddItemWithContext(context.Background(), item)
+}
+
+// Add an item to the cart, returning the cart with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md
+func (service *StoreCartService) AddItemWithContext(ctx context.Context, item *StoreCartItemRequest) (*StoreCart, *Response, error) {
+  cart := new(StoreCart)
+  response, err := service.api.do(ctx, "POST", "/cart/add-item", item, cart)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return cart, response, nil
+}
+
+// Update the quantity of a cart item, returning the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md
+func (service *StoreCartService) UpdateItem(itemKey string, quantity int) (*StoreCart, *Response, error) {
+  return service.UpdateItemWithContext(context.Background(), itemKey, quantity)
+}
+
+// Update the quantity of a cart item, returning the cart with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md
+func (service *StoreCartService) UpdateItemWithContext(ctx context.Context, itemKey string, quantity int) (*StoreCart, *Response, error) {
+  cart := new(StoreCart)
+  response, err := service.api.do(ctx, "POST", "/cart/update-item", &StoreCartItemRequest{Key: itemKey, Quantity: quantity}, cart)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return cart, response, nil
+}
+
+// Remove an item from the cart, returning the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md
+func (service *StoreCartService) RemoveItem(itemKey string) (*StoreCart, *Response, error) {
+  return service.RemoveItemWithContext(context.Background(), itemKey)
+}
+
+// Remove an item from the cart, returning the cart with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md
+func (service *StoreCartService) RemoveItemWithContext(ctx context.Context, itemKey string) (*StoreCart, *Response, error) {
+  cart := new(StoreCart)
+  response, err := service.api.do(ctx, "POST", "/cart/remove-item", &StoreCartItemRequest{Key: itemKey}, cart)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return cart, response, nil
+}
+
+// Apply a coupon to the cart, returning the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md
+func (service *StoreCartService) ApplyCoupon(code string) (*StoreCart, *Response, error) {
+  return service.ApplyCouponWithContext(context.Background(), code)
+}
+
+// Apply a coupon to the cart, returning the cart with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md
+func (service *StoreCartService) ApplyCouponWithContext(ctx context.Context, code string) (*StoreCart, *Response, error) {
+  cart := new(StoreCart)
+  response, err := service.api.do(ctx, "POST", "/cart/apply-coupon", &StoreCartCouponRequest{Code: code}, cart)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return cart, response, nil
+}
+
+// Remove a coupon from the cart, returning the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md
+func (service *StoreCartService) RemoveCoupon(code string) (*StoreCart, *Response, error) {
+  return service.RemoveCouponWithContext(context.Background(), code)
+}
+
+// Remove a coupon from the cart, returning the cart with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md
+func (service *StoreCartService) RemoveCouponWithContext(ctx context.Context, code string) (*StoreCart, *Response, error) {
+  cart := new(StoreCart)
+  response, err := service.api.do(ctx, "POST", "/cart/remove-coupon", &StoreCartCouponRequest{Code: code}, cart)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return cart, response, nil
+}
+
+// Update the cart customer addresses, returning the cart with its shipping rates. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md
+func (service *StoreCartService) UpdateCustomer(customer *StoreCartCustomerRequest) (*StoreCart, *Response, error) {
+  return service.UpdateCustomerWithContext(context.Background(), customer)
+}
+
+// Update the cart customer addresses, returning the cart with its shipping rates with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md
+func (service *StoreCartService) UpdateCustomerWithContext(ctx context.Context, customer *StoreCartCustomerRequest) (*StoreCart, *Response, error) {
+  cart := new(StoreCart)
+  response, err := service.api.do(ctx, "POST", "/cart/update-customer", customer, cart)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return cart, response, nil
+}
+
+// Select the shipping rate of a package, returning the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md
+func (service *StoreCartService) SelectShippingRate(packageID int, rateID string) (*StoreCart, *Response, error) {
+  return service.SelectShippingRateWithContext(context.Background(), packageID, rateID)
+}
+
+// Select the shipping rate of a package, returning the cart with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart.md
+func (service *StoreCartService) SelectShippingRateWithContext(ctx context.Context, packageID int, rateID string) (*StoreCart, *Response, error) {
+  cart := new(StoreCart)
+  response, err := service.api.do(ctx, "POST", "/cart/select-shipping-rate", &StoreShippingRateRequest{PackageId: packageID, RateId: rateID}, cart)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return cart, response, nil
+}
+
+// List the cart items. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-items.md
+func (service *StoreCartItemsService) List() (*[]StoreCartItem, *Response, error) {
+  return service.ListWithContext(context.Background())
+}
+
+// List the cart items with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-items.md
+func (service *StoreCartItemsService) ListWithContext(ctx context.Context) (*[]StoreCartItem, *Response, error) {
+  items := new([]StoreCartItem)
+  response, err := service.api.do(ctx, "GET", "/cart/items", nil, items)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return items, response, nil
+}
+
+// Get a cart item. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-items.md
+func (service *StoreCartItemsService) Get(itemKey string) (*StoreCartItem, *Response, error) {
+  return service.GetWithContext(context.Background(), itemKey)
+}
+
+// Get a cart item with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-items.md
+func (service *StoreCartItemsService) GetWithContext(ctx context.Context, itemKey string) (*StoreCartItem, *Response, error) {
+  item := new(StoreCartItem)
+  response, err := service.api.do(ctx, "GET", "/cart/items/" + url.PathEscape(itemKey), nil, item)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return item, response, nil
+}
+
+// Add an item to the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-items.md
+func (service *StoreCartItemsService) Add(item *StoreCartItemRequest) (*StoreCartItem, *Response, error) {
+  return service.AddWithContext(context.Background(), item)
+}
+
+// Add an item to the cart with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-items.md
+func (service *StoreCartItemsService) AddWithContext(ctx context.Context, item *StoreCartItemRequest) (*StoreCartItem, *Response, error) {
+  createdItem := new(StoreCartItem)
+  response, err := service.api.do(ctx, "POST", "/cart/items", item, createdItem)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return createdItem, response, nil
+}
+
+// Update a cart item. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-items.md
+func (service *StoreCartItemsService) Update(itemKey string, item *StoreCartItemRequest) (*StoreCartItem, *Response, error) {
+  return service.UpdateWithContext(context.Background(), itemKey, item)
+}
+
+// Update a cart item with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-items.md
+func (service *StoreCartItemsService) UpdateWithContext(ctx context.Context, itemKey string, item *StoreCartItemRequest) (*StoreCartItem, *Response, error) {
+  updatedItem := new(StoreCartItem)
+  response, err := service.api.do(ctx, "PUT", "/cart/items/" + url.PathEscape(itemKey), item, updatedItem)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return updatedItem, response, nil
+}
+
+// Delete a cart item. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-items.md
+func (service *StoreCartItemsService) Delete(itemKey string) (*StoreCartItem, *Response, error) {
+  return service.DeleteWithContext(context.Background(), itemKey)
+}
+
+// Delete a cart item with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-items.md
+func (service *StoreCartItemsService) DeleteWithContext(ctx context.Context, itemKey string) (*StoreCartItem, *Response, error) {
+  item := new(StoreCartItem)
+  response, err := service.api.do(ctx, "DELETE", "/cart/items/" + url.PathEscape(itemKey), nil, item)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return item, response, nil
+}
+
+// Delete all cart items. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-items.md
+func (service *StoreCartItemsService) DeleteAll() (*[]StoreCartItem, *Response, error) {
+  return service.DeleteAllWithContext(context.Background())
+}
+
+// Delete all cart items with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-items.md
+func (service *StoreCartItemsService) DeleteAllWithContext(ctx context.Context) (*[]StoreCartItem, *Response, error) {
+  items := new([]StoreCartItem)
+  response, err := service.api.do(ctx, "DELETE", "/cart/items", nil, items)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return items, response, nil
+}
+
+// List the cart coupons. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-coupons.md
+func (service *StoreCartCouponsService) List() (*[]StoreCartCoupon, *Response, error) {
+  return service.ListWithContext(context.Background())
+}
+
+// List the cart coupons with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-coupons.md
+func (service *StoreCartCouponsService) ListWithContext(ctx context.Context) (*[]StoreCartCoupon, *Response, error) {
+  coupons := new([]StoreCartCoupon)
+  response, err := service.api.do(ctx, "GET", "/cart/coupons", nil, coupons)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return coupons, response, nil
+}
+
+// Get a cart coupon. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-coupons.md
+func (service *StoreCartCouponsService) Get(code string) (*StoreCartCoupon, *Response, error) {
+  return service.GetWithContext(context.Background(), code)
+}
+
+// Get a cart coupon with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-coupons.md
+func (service *StoreCartCouponsService) GetWithContext(ctx context.Context, code string) (*StoreCartCoupon, *Response, error) {
+  coupon := new(StoreCartCoupon)
+  response, err := service.api.do(ctx, "GET", "/cart/coupons/" + url.PathEscape(code), nil, coupon)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return coupon, response, nil
+}
+
+// Apply a coupon to the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-coupons.md
+func (service *StoreCartCouponsService) Add(code string) (*StoreCartCoupon, *Response, error) {
+  return service.AddWithContext(context.Background(), code)
+}
+
+// Apply a coupon to the cart with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-coupons.md
+func (service *StoreCartCouponsService) AddWithContext(ctx context.Context, code string) (*StoreCartCoupon, *Response, error) {
+  coupon := new(StoreCartCoupon)
+  response, err := service.api.do(ctx, "POST", "/cart/coupons", &StoreCartCouponRequest{Code: code}, coupon)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return coupon, response, nil
+}
+
+// Remove a coupon from the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-coupons.md
+func (service *StoreCartCouponsService) Delete(code string) (*StoreCartCoupon, *Response, error) {
+  return service.DeleteWithContext(context.Background(), code)
+}
+
+// Remove a coupon from the cart with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-coupons.md
+func (service *StoreCartCouponsService) DeleteWithContext(ctx context.Context, code string) (*StoreCartCoupon, *Response, error) {
+  coupon := new(StoreCartCoupon)
+  response, err := service.api.do(ctx, "DELETE", "/cart/coupons/" + url.PathEscape(code), nil, coupon)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return coupon, response, nil
+}
+
+// Remove all coupons from the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-coupons.md
+func (service *StoreCartCouponsService) DeleteAll() (*[]StoreCartCoupon, *Response, error) {
+  return service.DeleteAllWithContext(context.Background())
+}
+
+// Remove all coupons from the cart with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/cart-coupons.md
+func (service *StoreCartCouponsService) DeleteAllWithContext(ctx context.Context) (*[]StoreCartCoupon, *Response, error) {
+  coupons := new([]StoreCartCoupon)
+  response, err := service.api.do(ctx, "DELETE", "/cart/coupons", nil, coupons)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return coupons, response, nil
+}
\ No newline at end of file
@@ -0,0 +1,81 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// Store API checkout service
+type StoreCheckoutService storeService
+
+// StoreCheckout object, the order created from the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/checkout.md
+type StoreCheckout struct {
+  OrderId          int                     `json:"order_id,omitempty"`
+  Status           string                  `json:"status,omitempty"`
+  OrderKey         string                  `json:"order_key,omitempty"`
+  OrderNumber      string                  `json:"order_number,omitempty"`
+  CustomerNote     string                  `json:"customer_note,omitempty"`
+  CustomerId       int                     `json:"customer_id,omitempty"`
+  BillingAddress   *Billing                `json:"billing_address,omitempty"`
+  ShippingAddress  *Shipping               `json:"shipping_address,omitempty"`
+  PaymentMethod    string                  `json:"payment_method,omitempty"`
+  PaymentResult    *StorePaymentResult     `json:"payment_result,omitempty"`
+  Extensions       map[string]interface{}  `json:"extensions,omitempty"`
+}
+
+// StorePaymentResult is the result of the payment, and where to redirect the customer (eg. to the payment provider)
+type StorePaymentResult struct {
+  PaymentStatus   string                `json:"payment_status,omitempty"`
+  PaymentDetails  []StorePaymentDetail  `json:"payment_details,omitempty"`
+  RedirectUrl     string                `json:"redirect_url,omitempty"`
+}
+
+// StorePaymentDetail is a key/value sent to, or returned by, the payment method
+type StorePaymentDetail struct {
+  Key    string  `json:"key"`
+  Value  string  `json:"value"`
+}
+
+// StoreCheckoutRequest is the body to place the order and pay for it
+type StoreCheckoutRequest struct {
+  BillingAddress   *Billing                `json:"billing_address,omitempty"`
+  ShippingAddress  *Shipping               `json:"shipping_address,omitempty"`
+  CustomerNote     string                  `json:"customer_note,omitempty"`
+  CreateAccount    bool                    `json:"create_account,omitempty"`
+  PaymentMethod    string                  `json:"payment_method,omitempty"`
+  PaymentData      []StorePaymentDetail    `json:"payment_data,omitempty"`
+  Extensions       map[string]interface{}  `json:"extensions,omitempty"`
+}
+
+// Get the draft order of the cart. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/checkout.md
+func (service *StoreCheckoutService) Get() (*StoreCheckout, *Response, error) {
+  return service.GetWithContext(context.Background())
+}
+
+// Get the draft order of the cart with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/checkout.md
+func (service *StoreCheckoutService) GetWithContext(ctx context.Context) (*StoreCheckout, *Response, error) {
+  checkout := new(StoreCheckout)
+  response, err := service.api.do(ctx, "GET", "/checkout", nil, checkout)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return checkout, response, nil
+}
+
+// Place the order and process its payment. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/checkout.md
+func (service *StoreCheckoutService) Process(checkout *StoreCheckoutRequest) (*StoreCheckout, *Response, error) {
+  return service.ProcessWithContext(context.Background(), checkout)
+}
+
+// Place the order and process its payment with context. Reference: https://github.com/woocommerce/woocommerce/blob/trunk/plugins/woocommerce/src/StoreApi/docs/checkout.md
+func (service *StoreCheckoutService) ProcessWithContext(ctx context.Context, checkout *StoreCheckoutRequest) (*StoreCheckout, *Response, error) {
+  processedCheckout := new(StoreCheckout)
+  response, err := service.api.do(ctx, "POST", "/checkout", checkout, processedCheckout)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return processedCheckout, response, nil
+}
\ No newline at end of file
@@ -0,0 +1,221 @@
+package woocommerce
+
+import (
+  "context"
+  "fmt"
+  "net/http"
+  "net/http/httptest"
+  "sync"
+  "testing"
+)
+
+// storeRequest is a request received by the fake Store API
+type storeRequest struct {
+  path      string
+  cartToken string
+  nonce     string
+}
+
+// fakeStoreAPI records the session headers of each request, and answers with the handler
+type fakeStoreAPI struct {
+  mutex    sync.Mutex
+  requests []storeRequest
+}
+
+func (fake *fakeStoreAPI) start(t *testing.T, handler func(w http.ResponseWriter, r *http.Request, attempt int)) *StoreAPI {
+  server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    fake.mutex.Lock()
+    fake.requests = append(fake.requests, storeRequest{path: r.URL.Path, cartToken: r.Header.Get(headerCartToken), nonce: r.Header.Get(headerNonce)})
+    attempt := len(fake.requests)
+    fake.mutex.Unlock()
+
+    w.Header().Set("Content-Type", "application/json")
+    handler(w, r, attempt)
+  }))
+
+  t.Cleanup(server.Close)
+
+  api, err := NewStoreAPI(server.URL)
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  return api
+}
+
+func (fake *fakeStoreAPI) received() []storeRequest {
+  fake.mutex.Lock()
+  defer fake.mutex.Unlock()
+
+  return append([]storeRequest{}, fake.requests...)
+}
+
+// writeInvalidNonce rejects a request the way the Store API does for an expired nonce
+func writeInvalidNonce(w http.ResponseWriter, nonce string) {
+  if nonce != "" {
+    w.Header().Set(headerNonce, nonce)
+  }
+
+  w.WriteHeader(http.StatusForbidden)
+  fmt.Fprintf(w, `{"code":"%s","message":"Nonce is invalid.","data":{"status":403}}`, errorCodeInvalidNonce)
+}
+
+func TestStoreAPISessionRoundTrip(t *testing.T) {
+  fake := &fakeStoreAPI{}
+
+  api := fake.start(t, func(w http.ResponseWriter, r *http.Request, attempt int) {
+    w.Header().Set(headerCartToken, "token-1")
+    w.Header().Set(headerNonce, fmt.Sprintf("nonce-%d", attempt))
+    fmt.Fprint(w, `{"items_count":1}`)
+  })
+
+  ctx := context.Background()
+
+  if _, _, err := api.Cart.GetWithContext(ctx); err != nil {
+    t.Fatal(err)
+  }
+
+  if api.CartToken() != "token-1" || api.Nonce() != "nonce-1" {
+    t.Fatalf("session = (%q, %q), want (token-1, nonce-1)", api.CartToken(), api.Nonce())
+  }
+
+  if _, _, err := api.Cart.AddItemWithContext(ctx, &StoreCartItemRequest{Id: 5, Quantity: 1}); err != nil {
+    t.Fatal(err)
+  }
+
+  requests := fake.received()
+
+  if requests[0].cartToken != "" || requests[0].nonce != "" {
+    t.Errorf("first request sent session headers %+v, want none", requests[0])
+  }
+
+  if requests[1].cartToken != "token-1" || requests[1].nonce != "nonce-1" {
+    t.Errorf("second request sent %+v, want token-1 and nonce-1", requests[1])
+  }
+
+  if api.Nonce() != "nonce-2" {
+    t.Errorf("Nonce() = %q, want nonce-2", api.Nonce())
+  }
+}
+
+func TestStoreAPILegacyNonceHeader(t *testing.T) {
+  fake := &fakeStoreAPI{}
+
+  api := fake.start(t, func(w http.ResponseWriter, r *http.Request, attempt int) {
+    if attempt == 1 {
+      w.Header().Set(headerLegacyNonce, "legacy-nonce")
+    }
+
+    fmt.Fprint(w, `{}`)
+  })
+
+  ctx := context.Background()
+
+  if _, _, err := api.Cart.GetWithContext(ctx); err != nil {
+    t.Fatal(err)
+  }
+
+  if api.Nonce() != "legacy-nonce" {
+    t.Fatalf("Nonce() = %q, want legacy-nonce", api.Nonce())
+  }
+
+  if _, _, err := api.Cart.ApplyCouponWithContext(ctx, "summer"); err != nil {
+    t.Fatal(err)
+  }
+
+  // The legacy header is only read, the nonce is sent back in the Nonce header
+  if requests := fake.received(); requests[1].nonce != "legacy-nonce" {
+    t.Errorf("second request nonce = %q, want legacy-nonce", requests[1].nonce)
+  }
+
+  if api.Nonce() != "legacy-nonce" {
+    t.Errorf("Nonce() = %q, want it kept when the response has no nonce", api.Nonce())
+  }
+}
+
+func TestStoreAPIReplaysOnceOnInvalidNonce(t *testing.T) {
+  tests := []struct {
+    name     string
+    handler  func(w http.ResponseWriter, r *http.Request, attempt int)
+    attempts int
+    wantErr  bool
+  }{
+    {
+      name: "accepted with the new nonce",
+      handler: func(w http.ResponseWriter, r *http.Request, attempt int) {
+        if r.Header.Get(headerNonce) != "fresh" {
+          writeInvalidNonce(w, "fresh")
+
+          return
+        }
+
+        fmt.Fprint(w, `{"items_count":1}`)
+      },
+      attempts: 2,
+    },
+    {
+      name: "rejected again",
+      handler: func(w http.ResponseWriter, r *http.Request, attempt int) {
+        writeInvalidNonce(w, fmt.Sprintf("fresh-%d", attempt))
+      },
+      attempts: 2,
+      wantErr:  true,
+    },
+    {
+      name: "nonce unchanged",
+      handler: func(w http.ResponseWriter, r *http.Request, attempt int) {
+        writeInvalidNonce(w, "stale")
+      },
+      attempts: 1,
+      wantErr:  true,
+    },
+    {
+      name: "no nonce returned",
+      handler: func(w http.ResponseWriter, r *http.Request, attempt int) {
+        writeInvalidNonce(w, "")
+      },
+      attempts: 1,
+      wantErr:  true,
+    },
+  }
+
+  for _, test := range tests {
+    t.Run(test.name, func(t *testing.T) {
+      fake := &fakeStoreAPI{}
+
+      api := fake.start(t, test.handler)
+      api.SetCartToken("token-1")
+      api.SetNonce("stale")
+
+      cart, _, err := api.Cart.AddItemWithContext(context.Background(), &StoreCartItemRequest{Id: 5, Quantity: 1})
+
+      if test.wantErr {
+        if apiError, ok := err.(*APIError); !ok || apiError.Code != errorCodeInvalidNonce {
+          t.Errorf("AddItem() error = %v, want %s", err, errorCodeInvalidNonce)
+        }
+      } else if err != nil || cart.ItemsCount != 1 {
+        t.Errorf("AddItem() = %+v, %v, want the cart", cart, err)
+      }
+
+      requests := fake.received()
+
+      if len(requests) != test.attempts {
+        t.Fatalf("attempts = %d, want %d", len(requests), test.attempts)
+      }
+
+      if requests[0].nonce != "stale" {
+        t.Errorf("first attempt nonce = %q, want stale", requests[0].nonce)
+      }
+
+      for _, request := range requests {
+        if request.cartToken != "token-1" {
+          t.Errorf("attempt sent cart token %q, want token-1", request.cartToken)
+        }
+      }
+
+      if test.attempts > 1 && requests[1].nonce == "stale" {
+        t.Error("replayed request was sent with the stale nonce")
+      }
+    })
+  }
+}
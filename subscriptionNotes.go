@@ -0,0 +1,88 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// Subscription notes service, for the WooCommerce Subscriptions extension
+type SubscriptionNotesService service
+
+// Create a subscription note. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#create-a-subscription-note
+func (service *SubscriptionNotesService) Create(subscriptionId string, subscriptionNote *OrderNote) (*OrderNote, *Response, error) {
+  return service.CreateWithContext(context.Background(), subscriptionId, subscriptionNote)
+}
+
+// Create a subscription note with context. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#create-a-subscription-note
+func (service *SubscriptionNotesService) CreateWithContext(ctx context.Context, subscriptionId string, subscriptionNote *OrderNote) (*OrderNote, *Response, error) {
+  _url := "/subscriptions/" + subscriptionId + "/notes"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, subscriptionNote)
+
+  createdSubscriptionNote := new(OrderNote)
+  response, err := service.client.Do(req, createdSubscriptionNote)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return createdSubscriptionNote, response, nil
+}
+
+// Get a subscription note. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#retrieve-a-subscription-note
+func (service *SubscriptionNotesService) Get(subscriptionId string, noteId string) (*OrderNote, *Response, error) {
+  return service.GetWithContext(context.Background(), subscriptionId, noteId)
+}
+
+// Get a subscription note with context. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#retrieve-a-subscription-note
+func (service *SubscriptionNotesService) GetWithContext(ctx context.Context, subscriptionId string, noteId string) (*OrderNote, *Response, error) {
+  _url := "/subscriptions/" + subscriptionId + "/notes/" + noteId
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  subscriptionNote := new(OrderNote)
+  response, err := service.client.Do(req, subscriptionNote)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return subscriptionNote, response, nil
+}
+
+// List subscription notes. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#list-all-subscription-notes
+func (service *SubscriptionNotesService) List(subscriptionId string, opts *ListOrderNotesParams) (*[]OrderNote, *Response, error) {
+  return service.ListWithContext(context.Background(), subscriptionId, opts)
+}
+
+// List subscription notes with context. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#list-all-subscription-notes
+func (service *SubscriptionNotesService) ListWithContext(ctx context.Context, subscriptionId string, opts *ListOrderNotesParams) (*[]OrderNote, *Response, error) {
+  _url := "/subscriptions/" + subscriptionId + "/notes"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  subscriptionNotes := new([]OrderNote)
+  response, err := service.client.Do(req, subscriptionNotes)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return subscriptionNotes, response, nil
+}
+
+// Delete a subscription note. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#delete-a-subscription-note
+func (service *SubscriptionNotesService) Delete(subscriptionId string, noteId string, opts *DeleteOrderNoteParams) (*OrderNote, *Response, error) {
+  return service.DeleteWithContext(context.Background(), subscriptionId, noteId, opts)
+}
+
+// Delete a subscription note with context. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#delete-a-subscription-note
+func (service *SubscriptionNotesService) DeleteWithContext(ctx context.Context, subscriptionId string, noteId string, opts *DeleteOrderNoteParams) (*OrderNote, *Response, error) {
+  _url := "/subscriptions/" + subscriptionId + "/notes/" + noteId
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
+
+  subscriptionNote := new(OrderNote)
+  response, err := service.client.Do(req, subscriptionNote)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return subscriptionNote, response, nil
+}
\ No newline at end of file
@@ -0,0 +1,307 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// Subscriptions service, for the WooCommerce Subscriptions extension
+type SubscriptionsService service
+
+// Subscription statuses
+const (
+  SubscriptionStatusPending       = "pending"
+  SubscriptionStatusActive        = "active"
+  SubscriptionStatusOnHold        = "on-hold"
+  SubscriptionStatusCancelled     = "cancelled"
+  SubscriptionStatusPendingCancel = "pending-cancel"
+  SubscriptionStatusSwitched      = "switched"
+  SubscriptionStatusExpired       = "expired"
+)
+
+// Subscription object. Dates are in the store timezone, or GMT for the fields suffixed by Gmt.
+// Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#subscription-properties
+type Subscription struct {
+  ID                        int                     `json:"id,omitempty"`
+  ParentID                  int                     `json:"parent_id,omitempty"`
+  CustomerID                int                     `json:"customer_id,omitempty"`
+  Status                    string                  `json:"status,omitempty"`
+  Number                    string                  `json:"number,omitempty"`
+  OrderKey                  string                  `json:"order_key,omitempty"`
+  CreatedVia                string                  `json:"created_via,omitempty"`
+  Version                   string                  `json:"version,omitempty"`
+  Currency                  string                  `json:"currency,omitempty"`
+  PricesIncludeTax          bool                    `json:"prices_include_tax,omitempty"`
+  DateCreated               string                  `json:"date_created,omitempty"`
+  DateCreatedGmt            string                  `json:"date_created_gmt,omitempty"`
+  DateModified              string                  `json:"date_modified,omitempty"`
+  DateModifiedGmt           string                  `json:"date_modified_gmt,omitempty"`
+  DiscountTotal             string                  `json:"discount_total,omitempty"`
+  DiscountTax               string                  `json:"discount_tax,omitempty"`
+  ShippingTotal             string                  `json:"shipping_total,omitempty"`
+  ShippingTax               string                  `json:"shipping_tax,omitempty"`
+  CartTax                   string                  `json:"cart_tax,omitempty"`
+  Total                     string                  `json:"total,omitempty"`
+  TotalTax                  string                  `json:"total_tax,omitempty"`
+  CustomerIPAddress         string                  `json:"customer_ip_address,omitempty"`
+  CustomerUserAgent         string                  `json:"customer_user_agent,omitempty"`
+  CustomerNote              string                  `json:"customer_note,omitempty"`
+  PaymentMethod             string                  `json:"payment_method,omitempty"`
+  PaymentMethodTitle        string                  `json:"payment_method_title,omitempty"`
+  PaymentDetails            map[string]interface{}  `json:"payment_details,omitempty"`
+  TransactionID             string                  `json:"transaction_id,omitempty"`
+  BillingPeriod             string                  `json:"billing_period,omitempty"`
+  BillingInterval           int                     `json:"billing_interval,omitempty"`
+  StartDate                 string                  `json:"start_date,omitempty"`
+  StartDateGmt              string                  `json:"start_date_gmt,omitempty"`
+  TrialEndDate              string                  `json:"trial_end_date,omitempty"`
+  TrialEndDateGmt           string                  `json:"trial_end_date_gmt,omitempty"`
+  NextPaymentDate           string                  `json:"next_payment_date,omitempty"`
+  NextPaymentDateGmt        string                  `json:"next_payment_date_gmt,omitempty"`
+  LastPaymentDate           string                  `json:"last_payment_date,omitempty"`
+  LastPaymentDateGmt        string                  `json:"last_payment_date_gmt,omitempty"`
+  CancelledDate             string                  `json:"cancelled_date,omitempty"`
+  CancelledDateGmt          string                  `json:"cancelled_date_gmt,omitempty"`
+  EndDate                   string                  `json:"end_date,omitempty"`
+  EndDateGmt                string                  `json:"end_date_gmt,omitempty"`
+  ResubscribedFrom          string                  `json:"resubscribed_from,omitempty"`
+  ResubscribedSubscription  string                  `json:"resubscribed_subscription,omitempty"`
+  Billing                   *Billing                `json:"billing,omitempty"`
+  Shipping                  *Shipping               `json:"shipping,omitempty"`
+  Links                     *Links                  `json:"_links,omitempty"`
+  FeeLines                  *[]FeeLine              `json:"fee_lines,omitempty"`
+  MetaData                  *[]MetaData             `json:"meta_data,omitempty"`
+  CouponLines               *[]CouponLine           `json:"coupon_lines,omitempty"`
+  LineItems                 *[]LineItems            `json:"line_items,omitempty"`
+  RemovedLineItems          *[]LineItems            `json:"removed_line_items,omitempty"`
+  TaxLines                  *[]TaxLines             `json:"tax_lines,omitempty"`
+  ShippingLines             *[]ShippingLines        `json:"shipping_lines,omitempty"`
+}
+
+type ListSubscriptionsParams struct {
+  Context          string    `url:"context,omitempty"`
+  Page             int       `url:"page,omitempty"`
+  PerPage          int       `url:"per_page,omitempty"`
+  Search           string    `url:"search,omitempty"`
+  After            string    `url:"after,omitempty"`
+  Before           string    `url:"before,omitempty"`
+  Exclude          *[]int    `url:"exclude,omitempty"`
+  Include          *[]int    `url:"include,omitempty"`
+  Offset           int       `url:"offset,omitempty"`
+  Order            string    `url:"order,omitempty"`
+  OrderBy          string    `url:"orderby,omitempty"`
+  Parent           *[]int    `url:"parent,omitempty"`
+  ParentExclude    *[]int    `url:"parent_exclude,omitempty"`
+  Status           string    `url:"status,omitempty"`
+  Customer         int       `url:"customer,omitempty"`
+  Product          int       `url:"product,omitempty"`
+  DecimalPoints    int       `url:"dp,omitempty"`
+}
+
+type DeleteSubscriptionParams struct {
+  Force    bool       `url:"force"`
+}
+
+type BatchSubscriptionUpdate struct {
+  Create  *[]Subscription `json:"create,omitempty"`
+  Update  *[]Subscription `json:"update,omitempty"`
+  Delete  *[]int          `json:"delete,omitempty"`
+}
+
+type BatchSubscriptionUpdateResponse struct {
+  Create  *[]Subscription `json:"create,omitempty"`
+  Update  *[]Subscription `json:"update,omitempty"`
+  Delete  *[]Subscription `json:"delete,omitempty"`
+}
+
+// Create a subscription. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#create-a-subscription
+func (service *SubscriptionsService) Create(subscription *Subscription) (*Subscription, *Response, error) {
+  return service.CreateWithContext(context.Background(), subscription)
+}
+
+// Create a subscription with context. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#create-a-subscription
+func (service *SubscriptionsService) CreateWithContext(ctx context.Context, subscription *Subscription) (*Subscription, *Response, error) {
+  _url := "/subscriptions"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, subscription)
+
+  createdSubscription := new(Subscription)
+  response, err := service.client.Do(req, createdSubscription)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return createdSubscription, response, nil
+}
+
+// Get a subscription. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#retrieve-a-subscription
+func (service *SubscriptionsService) Get(subscriptionId string) (*Subscription, *Response, error) {
+  return service.GetWithContext(context.Background(), subscriptionId)
+}
+
+// Get a subscription with context. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#retrieve-a-subscription
+func (service *SubscriptionsService) GetWithContext(ctx context.Context, subscriptionId string) (*Subscription, *Response, error) {
+  _url := "/subscriptions/" + subscriptionId
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  subscription := new(Subscription)
+  response, err := service.client.Do(req, subscription)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return subscription, response, nil
+}
+
+// List subscriptions. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#list-all-subscriptions
+func (service *SubscriptionsService) List(opts *ListSubscriptionsParams) (*[]Subscription, *Response, error) {
+  return service.ListWithContext(context.Background(), opts)
+}
+
+// List subscriptions with context. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#list-all-subscriptions
+func (service *SubscriptionsService) ListWithContext(ctx context.Context, opts *ListSubscriptionsParams) (*[]Subscription, *Response, error) {
+  _url := "/subscriptions"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  subscriptions := new([]Subscription)
+  response, err := service.client.Do(req, subscriptions)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return subscriptions, response, nil
+}
+
+// Update a subscription. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#update-a-subscription
+func (service *SubscriptionsService) Update(subscriptionId string, subscription *Subscription) (*Subscription, *Response, error) {
+  return service.UpdateWithContext(context.Background(), subscriptionId, subscription)
+}
+
+// Update a subscription with context. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#update-a-subscription
+func (service *SubscriptionsService) UpdateWithContext(ctx context.Context, subscriptionId string, subscription *Subscription) (*Subscription, *Response, error) {
+  _url := "/subscriptions/" + subscriptionId
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, subscription)
+
+  updatedSubscription := new(Subscription)
+  response, err := service.client.Do(req, updatedSubscription)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return updatedSubscription, response, nil
+}
+
+// Delete a subscription. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#delete-a-subscription
+func (service *SubscriptionsService) Delete(subscriptionId string, opts *DeleteSubscriptionParams) (*Subscription, *Response, error) {
+  return service.DeleteWithContext(context.Background(), subscriptionId, opts)
+}
+
+// Delete a subscription with context. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#delete-a-subscription
+func (service *SubscriptionsService) DeleteWithContext(ctx context.Context, subscriptionId string, opts *DeleteSubscriptionParams) (*Subscription, *Response, error) {
+  _url := "/subscriptions/" + subscriptionId
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
+
+  subscription := new(Subscription)
+  response, err := service.client.Do(req, subscription)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return subscription, response, nil
+}
+
+// Batch update subscriptions. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#batch-update-subscriptions
+func (service *SubscriptionsService) Batch(opts *BatchSubscriptionUpdate) (*BatchSubscriptionUpdateResponse, *Response, error) {
+  return service.BatchWithContext(context.Background(), opts)
+}
+
+// Batch update subscriptions with context. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#batch-update-subscriptions
+func (service *SubscriptionsService) BatchWithContext(ctx context.Context, opts *BatchSubscriptionUpdate) (*BatchSubscriptionUpdateResponse, *Response, error) {
+  _url := "/subscriptions/batch"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, opts)
+
+  subscriptions := new(BatchSubscriptionUpdateResponse)
+  response, err := service.client.Do(req, subscriptions)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return subscriptions, response, nil
+}
+
+// Paginate subscriptions, fetching each page as it is iterated. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#list-all-subscriptions
+func (service *SubscriptionsService) Paginate(opts *ListSubscriptionsParams) *Paginator[Subscription] {
+  params := ListSubscriptionsParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]Subscription, *Response, error) {
+    params.Page = page
+    subscriptions, response, err := service.ListWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *subscriptions, response, nil
+  })
+}
+
+// List all subscriptions across every page. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#list-all-subscriptions
+func (service *SubscriptionsService) ListAll(ctx context.Context, opts *ListSubscriptionsParams) ([]Subscription, error) {
+  return service.Paginate(opts).Collect(ctx)
+}
+
+// List the orders related to a subscription (parent, renewal, switch and resubscribe orders).
+// Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#list-all-subscription-orders
+func (service *SubscriptionsService) RelatedOrders(subscriptionId string, opts *ListOrdersParams) (*[]Order, *Response, error) {
+  return service.RelatedOrdersWithContext(context.Background(), subscriptionId, opts)
+}
+
+// List the orders related to a subscription with context. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#list-all-subscription-orders
+func (service *SubscriptionsService) RelatedOrdersWithContext(ctx context.Context, subscriptionId string, opts *ListOrdersParams) (*[]Order, *Response, error) {
+  _url := "/subscriptions/" + subscriptionId + "/orders"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  orders := new([]Order)
+  response, err := service.client.Do(req, orders)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return orders, response, nil
+}
+
+// Activate a subscription (eg. reactivate an on-hold subscription). Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#update-a-subscription
+func (service *SubscriptionsService) Activate(ctx context.Context, subscriptionId string) (*Subscription, *Response, error) {
+  return service.SetStatus(ctx, subscriptionId, SubscriptionStatusActive)
+}
+
+// Put a subscription on hold, suspending its renewals. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#update-a-subscription
+func (service *SubscriptionsService) Hold(ctx context.Context, subscriptionId string) (*Subscription, *Response, error) {
+  return service.SetStatus(ctx, subscriptionId, SubscriptionStatusOnHold)
+}
+
+// Cancel a subscription immediately. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#update-a-subscription
+func (service *SubscriptionsService) Cancel(ctx context.Context, subscriptionId string) (*Subscription, *Response, error) {
+  return service.SetStatus(ctx, subscriptionId, SubscriptionStatusCancelled)
+}
+
+// PendingCancel cancels a subscription at the end of its prepaid term. Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#update-a-subscription
+func (service *SubscriptionsService) PendingCancel(ctx context.Context, subscriptionId string) (*Subscription, *Response, error) {
+  return service.SetStatus(ctx, subscriptionId, SubscriptionStatusPendingCancel)
+}
+
+// SetStatus moves a subscription to the given status. The store rejects transitions that are not
+// allowed (eg. activating a cancelled subscription).
+// Reference: https://woocommerce.github.io/subscriptions-rest-api-docs/#update-a-subscription
+func (service *SubscriptionsService) SetStatus(ctx context.Context, subscriptionId string, status string) (*Subscription, *Response, error) {
+  return service.UpdateWithContext(ctx, subscriptionId, &Subscription{Status: status})
+}
\ No newline at end of file
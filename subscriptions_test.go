@@ -0,0 +1,128 @@
+package woocommerce
+
+import (
+  "context"
+  "fmt"
+  "io"
+  "net/http"
+  "net/http/httptest"
+  "net/url"
+  "testing"
+)
+
+// subscriptionRequest is a request received by the fake subscriptions server
+type subscriptionRequest struct {
+  method string
+  path   string
+  query  string
+  body   string
+}
+
+func newTestSubscriptionsClient(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) (*Client, *[]subscriptionRequest) {
+  requests := &[]subscriptionRequest{}
+
+  server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    body, _ := io.ReadAll(r.Body)
+    *requests = append(*requests, subscriptionRequest{method: r.Method, path: r.URL.Path, query: r.URL.RawQuery, body: string(body)})
+
+    handler(w, r)
+  }))
+
+  t.Cleanup(server.Close)
+
+  client, err := New(server.URL)
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  return client, requests
+}
+
+func TestSubscriptionStatusTransitions(t *testing.T) {
+  tests := []struct {
+    name       string
+    transition func(service *SubscriptionsService, ctx context.Context, subscriptionId string) (*Subscription, *Response, error)
+    status     string
+  }{
+    {name: "Activate", transition: (*SubscriptionsService).Activate, status: SubscriptionStatusActive},
+    {name: "Hold", transition: (*SubscriptionsService).Hold, status: SubscriptionStatusOnHold},
+    {name: "Cancel", transition: (*SubscriptionsService).Cancel, status: SubscriptionStatusCancelled},
+    {name: "PendingCancel", transition: (*SubscriptionsService).PendingCancel, status: SubscriptionStatusPendingCancel},
+  }
+
+  for _, test := range tests {
+    t.Run(test.name, func(t *testing.T) {
+      client, requests := newTestSubscriptionsClient(t, func(w http.ResponseWriter, r *http.Request) {
+        w.Header().Set("X-WP-Total", "1")
+        fmt.Fprintf(w, `{"id":123,"status":"%s"}`, test.status)
+      })
+
+      subscription, response, err := test.transition(client.Subscriptions, context.Background(), "123")
+      if err != nil {
+        t.Fatal(err)
+      }
+
+      if subscription.ID != 123 || subscription.Status != test.status {
+        t.Errorf("subscription = %+v, want 123 with status %s", subscription, test.status)
+      }
+
+      if response == nil || response.StatusCode != http.StatusOK {
+        t.Errorf("response = %+v, want the HTTP response", response)
+      }
+
+      request := (*requests)[0]
+
+      if request.method != http.MethodPut || request.path != "/wp-json/wc/v3/subscriptions/123" {
+        t.Errorf("request = %s %s, want PUT /wp-json/wc/v3/subscriptions/123", request.method, request.path)
+      }
+
+      if want := `{"status":"` + test.status + `"}` + "\n"; request.body != want {
+        t.Errorf("request body = %q, want %q", request.body, want)
+      }
+    })
+  }
+}
+
+func TestSubscriptionSetStatusRejected(t *testing.T) {
+  client, _ := newTestSubscriptionsClient(t, func(w http.ResponseWriter, r *http.Request) {
+    w.WriteHeader(http.StatusBadRequest)
+    fmt.Fprint(w, `{"code":"woocommerce_rest_invalid_shop_subscription_status","message":"Unable to change status.","data":{"status":400}}`)
+  })
+
+  subscription, response, err := client.Subscriptions.SetStatus(context.Background(), "123", SubscriptionStatusActive)
+
+  if err == nil || subscription != nil {
+    t.Fatalf("SetStatus() = %+v, %v, want an error", subscription, err)
+  }
+
+  if response == nil || response.StatusCode != http.StatusBadRequest {
+    t.Errorf("response = %+v, want the HTTP 400 response", response)
+  }
+}
+
+func TestSubscriptionRelatedOrders(t *testing.T) {
+  client, requests := newTestSubscriptionsClient(t, func(w http.ResponseWriter, r *http.Request) {
+    w.Header().Set("X-WP-Total", "2")
+    w.Header().Set("X-WP-TotalPages", "1")
+    fmt.Fprint(w, `[{"id":200,"status":"completed"},{"id":201,"status":"processing"}]`)
+  })
+
+  orders, response, err := client.Subscriptions.RelatedOrders("123", &ListOrdersParams{PerPage: 5})
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  request := (*requests)[0]
+
+  if request.method != http.MethodGet || request.path != "/wp-json/wc/v3/subscriptions/123/orders" {
+    t.Errorf("request = %s %s, want GET /wp-json/wc/v3/subscriptions/123/orders", request.method, request.path)
+  }
+
+  if query, _ := url.ParseQuery(request.query); query.Get("per_page") != "5" {
+    t.Errorf("request query = %q, want per_page=5", request.query)
+  }
+
+  if len(*orders) != 2 || (*orders)[0].ID != 200 || response.TotalItems != 2 {
+    t.Errorf("orders = %+v (total %d), want orders 200 and 201", *orders, response.TotalItems)
+  }
+}
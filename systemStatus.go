@@ -0,0 +1,170 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// System status service
+type SystemStatusService service
+
+// SystemStatus object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#system-status-properties
+type SystemStatus struct {
+  Environment       *SystemStatusEnvironment   `json:"environment,omitempty"`
+  Database          *SystemStatusDatabase      `json:"database,omitempty"`
+  ActivePlugins     []SystemStatusPlugin       `json:"active_plugins,omitempty"`
+  InactivePlugins   []SystemStatusPlugin       `json:"inactive_plugins,omitempty"`
+  DropinsMuPlugins  *SystemStatusDropins       `json:"dropins_mu_plugins,omitempty"`
+  Theme             *SystemStatusTheme         `json:"theme,omitempty"`
+  Settings          *SystemStatusSettings      `json:"settings,omitempty"`
+  Security          *SystemStatusSecurity      `json:"security,omitempty"`
+  Pages             []SystemStatusPage         `json:"pages,omitempty"`
+}
+
+// SystemStatusEnvironment object. RemotePostResponse and RemoteGetResponse are the HTTP status code, or an error message.
+// Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#system-status-environment-properties
+type SystemStatusEnvironment struct {
+  HomeUrl                 string       `json:"home_url,omitempty"`
+  SiteUrl                 string       `json:"site_url,omitempty"`
+  Version                 string       `json:"version,omitempty"`
+  LogDirectory            string       `json:"log_directory,omitempty"`
+  LogDirectoryWritable    bool         `json:"log_directory_writable,omitempty"`
+  WpVersion               string       `json:"wp_version,omitempty"`
+  WpMultisite             bool         `json:"wp_multisite,omitempty"`
+  WpMemoryLimit           int          `json:"wp_memory_limit,omitempty"`
+  WpDebugMode             bool         `json:"wp_debug_mode,omitempty"`
+  WpCron                  bool         `json:"wp_cron,omitempty"`
+  Language                string       `json:"language,omitempty"`
+  ExternalObjectCache     bool         `json:"external_object_cache,omitempty"`
+  ServerInfo              string       `json:"server_info,omitempty"`
+  PhpVersion              string       `json:"php_version,omitempty"`
+  PhpPostMaxSize          int          `json:"php_post_max_size,omitempty"`
+  PhpMaxExecutionTime     int          `json:"php_max_execution_time,omitempty"`
+  PhpMaxInputVars         int          `json:"php_max_input_vars,omitempty"`
+  CurlVersion             string       `json:"curl_version,omitempty"`
+  SuhosinInstalled        bool         `json:"suhosin_installed,omitempty"`
+  MaxUploadSize           int          `json:"max_upload_size,omitempty"`
+  MysqlVersion            string       `json:"mysql_version,omitempty"`
+  MysqlVersionString      string       `json:"mysql_version_string,omitempty"`
+  DefaultTimezone         string       `json:"default_timezone,omitempty"`
+  FsockopenOrCurlEnabled  bool         `json:"fsockopen_or_curl_enabled,omitempty"`
+  SoapclientEnabled       bool         `json:"soapclient_enabled,omitempty"`
+  DomdocumentEnabled      bool         `json:"domdocument_enabled,omitempty"`
+  GzipEnabled             bool         `json:"gzip_enabled,omitempty"`
+  MbstringEnabled         bool         `json:"mbstring_enabled,omitempty"`
+  RemotePostSuccessful    bool         `json:"remote_post_successful,omitempty"`
+  RemotePostResponse      interface{}  `json:"remote_post_response,omitempty"`
+  RemoteGetSuccessful     bool         `json:"remote_get_successful,omitempty"`
+  RemoteGetResponse       interface{}  `json:"remote_get_response,omitempty"`
+}
+
+// SystemStatusDatabase object. DatabaseTables groups tables by "woocommerce" and "other", each
+// table holding its size and engine (or false, when a WooCommerce table is missing).
+// Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#system-status-database-properties
+type SystemStatusDatabase struct {
+  WcDatabaseVersion     string                            `json:"wc_database_version,omitempty"`
+  DatabasePrefix        string                            `json:"database_prefix,omitempty"`
+  MaxmindGeoipDatabase  string                            `json:"maxmind_geoip_database,omitempty"`
+  DatabaseTables        map[string]map[string]interface{} `json:"database_tables,omitempty"`
+  DatabaseSize          *SystemStatusDatabaseSize         `json:"database_size,omitempty"`
+}
+
+// SystemStatusDatabaseSize is the size of the database, in MB
+type SystemStatusDatabaseSize struct {
+  Data   float64   `json:"data,omitempty"`
+  Index  float64   `json:"index,omitempty"`
+}
+
+// SystemStatusPlugin object, listed in the active and inactive plugins
+type SystemStatusPlugin struct {
+  Plugin            string    `json:"plugin,omitempty"`
+  Name              string    `json:"name,omitempty"`
+  Version           string    `json:"version,omitempty"`
+  VersionLatest     string    `json:"version_latest,omitempty"`
+  Url               string    `json:"url,omitempty"`
+  AuthorName        string    `json:"author_name,omitempty"`
+  AuthorUrl         string    `json:"author_url,omitempty"`
+  NetworkActivated  bool      `json:"network_activated,omitempty"`
+}
+
+// SystemStatusDropins lists the drop-ins and must-use plugins
+type SystemStatusDropins struct {
+  Dropins    []SystemStatusPlugin  `json:"dropins,omitempty"`
+  MuPlugins  []SystemStatusPlugin  `json:"mu_plugins,omitempty"`
+}
+
+// SystemStatusTheme object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#system-status-theme-properties
+type SystemStatusTheme struct {
+  Name                   string                          `json:"name,omitempty"`
+  Version                string                          `json:"version,omitempty"`
+  VersionLatest          string                          `json:"version_latest,omitempty"`
+  AuthorUrl              string                          `json:"author_url,omitempty"`
+  IsChildTheme           bool                            `json:"is_child_theme,omitempty"`
+  HasWoocommerceSupport  bool                            `json:"has_woocommerce_support,omitempty"`
+  HasWoocommerceFile     bool                            `json:"has_woocommerce_file,omitempty"`
+  HasOutdatedTemplates   bool                            `json:"has_outdated_templates,omitempty"`
+  Overrides              []SystemStatusTemplateOverride  `json:"overrides,omitempty"`
+  ParentName             string                          `json:"parent_name,omitempty"`
+  ParentVersion          string                          `json:"parent_version,omitempty"`
+  ParentVersionLatest    string                          `json:"parent_version_latest,omitempty"`
+  ParentAuthorUrl        string                          `json:"parent_author_url,omitempty"`
+}
+
+// SystemStatusTemplateOverride is a WooCommerce template overridden by the theme
+type SystemStatusTemplateOverride struct {
+  File         string    `json:"file,omitempty"`
+  Version      string    `json:"version,omitempty"`
+  CoreVersion  string    `json:"core_version,omitempty"`
+}
+
+// SystemStatusSettings object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#system-status-settings-properties
+type SystemStatusSettings struct {
+  ApiEnabled              bool               `json:"api_enabled,omitempty"`
+  ForceSsl                bool               `json:"force_ssl,omitempty"`
+  Currency                string             `json:"currency,omitempty"`
+  CurrencySymbol          string             `json:"currency_symbol,omitempty"`
+  CurrencyPosition        string             `json:"currency_position,omitempty"`
+  ThousandSeparator       string             `json:"thousand_separator,omitempty"`
+  DecimalSeparator        string             `json:"decimal_separator,omitempty"`
+  NumberOfDecimals        int                `json:"number_of_decimals,omitempty"`
+  GeolocationEnabled      bool               `json:"geolocation_enabled,omitempty"`
+  Taxonomies              map[string]string  `json:"taxonomies,omitempty"`
+  ProductVisibilityTerms  map[string]string  `json:"product_visibility_terms,omitempty"`
+}
+
+// SystemStatusSecurity object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#system-status-security-properties
+type SystemStatusSecurity struct {
+  SecureConnection  bool      `json:"secure_connection,omitempty"`
+  HideErrors        bool      `json:"hide_errors,omitempty"`
+}
+
+// SystemStatusPage is a WooCommerce page (eg. cart, checkout). PageId is the page ID, or false when it is not set.
+type SystemStatusPage struct {
+  PageName           string       `json:"page_name,omitempty"`
+  PageId             interface{}  `json:"page_id,omitempty"`
+  PageSet            bool         `json:"page_set,omitempty"`
+  PageExists         bool         `json:"page_exists,omitempty"`
+  PageVisible        bool         `json:"page_visible,omitempty"`
+  Shortcode          string       `json:"shortcode,omitempty"`
+  ShortcodeRequired  bool         `json:"shortcode_required,omitempty"`
+  ShortcodePresent   bool         `json:"shortcode_present,omitempty"`
+}
+
+// Get the system status report. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-system-status-items
+func (service *SystemStatusService) Get() (*SystemStatus, *Response, error) {
+  return service.GetWithContext(context.Background())
+}
+
+// Get the system status report with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-system-status-items
+func (service *SystemStatusService) GetWithContext(ctx context.Context) (*SystemStatus, *Response, error) {
+  _url := "/system_status"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  status := new(SystemStatus)
+  response, err := service.client.Do(req, status)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return status, response, nil
+}
\ No newline at end of file
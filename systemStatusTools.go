@@ -0,0 +1,97 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// System status tools service
+type SystemStatusToolsService service
+
+// System status tool IDs
+const (
+  SystemStatusToolClearTransients                 = "clear_transients"
+  SystemStatusToolClearExpiredTransients          = "clear_expired_transients"
+  SystemStatusToolDeleteOrphanedVariations        = "delete_orphaned_variations"
+  SystemStatusToolClearExpiredDownloadPermissions = "clear_expired_download_permissions"
+  SystemStatusToolRegenerateProductLookupTables   = "regenerate_product_lookup_tables"
+  SystemStatusToolRecountTerms                    = "recount_terms"
+  SystemStatusToolResetRoles                      = "reset_roles"
+  SystemStatusToolClearSessions                   = "clear_sessions"
+  SystemStatusToolClearTemplateCache              = "clear_template_cache"
+  SystemStatusToolInstallPages                    = "install_pages"
+  SystemStatusToolDeleteTaxes                     = "delete_taxes"
+  SystemStatusToolDbUpdateRoutine                 = "db_update_routine"
+  SystemStatusToolVerifyDbTables                  = "verify_db_tables"
+)
+
+// SystemStatusTool object. Success and Message are set once the tool is run.
+// Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#system-status-tool-properties
+type SystemStatusTool struct {
+  Id           string    `json:"id,omitempty"`
+  Name         string    `json:"name,omitempty"`
+  Action       string    `json:"action,omitempty"`
+  Description  string    `json:"description,omitempty"`
+  Success      bool      `json:"success,omitempty"`
+  Message      string    `json:"message,omitempty"`
+  Links        *Links    `json:"_links,omitempty"`
+}
+
+// Get a system status tool. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-tool-from-system-status
+func (service *SystemStatusToolsService) Get(toolID string) (*SystemStatusTool, *Response, error) {
+  return service.GetWithContext(context.Background(), toolID)
+}
+
+// Get a system status tool with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-tool-from-system-status
+func (service *SystemStatusToolsService) GetWithContext(ctx context.Context, toolID string) (*SystemStatusTool, *Response, error) {
+  _url := "/system_status/tools/" + toolID
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  tool := new(SystemStatusTool)
+  response, err := service.client.Do(req, tool)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return tool, response, nil
+}
+
+// List system status tools. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-tools-from-system-status
+func (service *SystemStatusToolsService) List() (*[]SystemStatusTool, *Response, error) {
+  return service.ListWithContext(context.Background())
+}
+
+// List system status tools with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-tools-from-system-status
+func (service *SystemStatusToolsService) ListWithContext(ctx context.Context) (*[]SystemStatusTool, *Response, error) {
+  _url := "/system_status/tools"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  tools := new([]SystemStatusTool)
+  response, err := service.client.Do(req, tools)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return tools, response, nil
+}
+
+// Run a system status tool. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#run-a-tool-from-system-status
+func (service *SystemStatusToolsService) Run(toolID string) (*SystemStatusTool, *Response, error) {
+  return service.RunWithContext(context.Background(), toolID)
+}
+
+// Run a system status tool with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#run-a-tool-from-system-status
+func (service *SystemStatusToolsService) RunWithContext(ctx context.Context, toolID string) (*SystemStatusTool, *Response, error) {
+  _url := "/system_status/tools/" + toolID
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, nil)
+
+  tool := new(SystemStatusTool)
+  response, err := service.client.Do(req, tool)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return tool, response, nil
+}
\ No newline at end of file
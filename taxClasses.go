@@ -0,0 +1,86 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// Tax classes service
+type TaxClassesService service
+
+// TaxClassStandard is the slug of the standard tax class (sent as an empty class on tax rates and products)
+const TaxClassStandard = "standard"
+
+// TaxClass object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#tax-class-properties
+type TaxClass struct {
+  Slug   string    `json:"slug,omitempty"`
+  Name   string    `json:"name,omitempty"`
+  Links  *Links    `json:"_links,omitempty"`
+}
+
+type ListTaxClassesParams struct {
+  Context  string    `url:"context,omitempty"`
+}
+
+type DeleteTaxClassParams struct {
+  Force    bool       `url:"force"`
+}
+
+// Create a tax class. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-tax-class
+func (service *TaxClassesService) Create(taxClass *TaxClass) (*TaxClass, *Response, error) {
+  return service.CreateWithContext(context.Background(), taxClass)
+}
+
+// Create a tax class with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-tax-class
+func (service *TaxClassesService) CreateWithContext(ctx context.Context, taxClass *TaxClass) (*TaxClass, *Response, error) {
+  _url := "/taxes/classes"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, taxClass)
+
+  createdTaxClass := new(TaxClass)
+  response, err := service.client.Do(req, createdTaxClass)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return createdTaxClass, response, nil
+}
+
+// List tax classes. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-tax-classes
+func (service *TaxClassesService) List(opts *ListTaxClassesParams) (*[]TaxClass, *Response, error) {
+  return service.ListWithContext(context.Background(), opts)
+}
+
+// List tax classes with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-tax-classes
+func (service *TaxClassesService) ListWithContext(ctx context.Context, opts *ListTaxClassesParams) (*[]TaxClass, *Response, error) {
+  _url := "/taxes/classes"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  taxClasses := new([]TaxClass)
+  response, err := service.client.Do(req, taxClasses)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return taxClasses, response, nil
+}
+
+// Delete a tax class. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-tax-class
+func (service *TaxClassesService) Delete(slug string, opts *DeleteTaxClassParams) (*TaxClass, *Response, error) {
+  return service.DeleteWithContext(context.Background(), slug, opts)
+}
+
+// Delete a tax class with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-tax-class
+func (service *TaxClassesService) DeleteWithContext(ctx context.Context, slug string, opts *DeleteTaxClassParams) (*TaxClass, *Response, error) {
+  _url := "/taxes/classes/" + slug
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
+
+  taxClass := new(TaxClass)
+  response, err := service.client.Do(req, taxClass)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return taxClass, response, nil
+}
\ No newline at end of file
@@ -0,0 +1,202 @@
+package woocommerce
+
+import (
+  "context"
+)
+
+// Tax rates service
+type TaxRatesService service
+
+// TaxRate object. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#tax-rate-properties
+// Compound and Shipping are only sent when set, so an update keeps their current values. WooCommerce
+// creates rates that apply to shipping unless Shipping is set to false.
+// Error is only set on objects of a batch response that failed.
+type TaxRate struct {
+  Id         int              `json:"id,omitempty"`
+  Country    string           `json:"country,omitempty"`
+  State      string           `json:"state,omitempty"`
+  Postcode   string           `json:"postcode,omitempty"`
+  City       string           `json:"city,omitempty"`
+  Postcodes  []string         `json:"postcodes,omitempty"`
+  Cities     []string         `json:"cities,omitempty"`
+  Rate       string           `json:"rate,omitempty"`
+  Name       string           `json:"name,omitempty"`
+  Priority   int              `json:"priority,omitempty"`
+  Compound   *bool            `json:"compound,omitempty"`
+  Shipping   *bool            `json:"shipping,omitempty"`
+  Order      int              `json:"order,omitempty"`
+  Class      string           `json:"class,omitempty"`
+  Links      *Links           `json:"_links,omitempty"`
+  Error      *BatchItemError  `json:"error,omitempty"`
+}
+
+type ListTaxRatesParams struct {
+  Context   string    `url:"context,omitempty"`
+  Page      int       `url:"page,omitempty"`
+  PerPage   int       `url:"per_page,omitempty"`
+  Offset    int       `url:"offset,omitempty"`
+  Order     string    `url:"order,omitempty"`
+  OrderBy   string    `url:"orderby,omitempty"`
+  Class     string    `url:"class,omitempty"`
+}
+
+type DeleteTaxRateParams struct {
+  Force    bool       `url:"force"`
+}
+
+type BatchTaxRateUpdate struct {
+  Create  *[]TaxRate `json:"create,omitempty"`
+  Update  *[]TaxRate `json:"update,omitempty"`
+  Delete  *[]int     `json:"delete,omitempty"`
+}
+
+type BatchTaxRateUpdateResponse struct {
+  Create  *[]TaxRate `json:"create,omitempty"`
+  Update  *[]TaxRate `json:"update,omitempty"`
+  Delete  *[]TaxRate `json:"delete,omitempty"`
+}
+
+// Create a tax rate. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-tax-rate
+func (service *TaxRatesService) Create(taxRate *TaxRate) (*TaxRate, *Response, error) {
+  return service.CreateWithContext(context.Background(), taxRate)
+}
+
+// Create a tax rate with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-tax-rate
+func (service *TaxRatesService) CreateWithContext(ctx context.Context, taxRate *TaxRate) (*TaxRate, *Response, error) {
+  _url := "/taxes"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, taxRate)
+
+  createdTaxRate := new(TaxRate)
+  response, err := service.client.Do(req, createdTaxRate)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return createdTaxRate, response, nil
+}
+
+// Get a tax rate. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-tax-rate
+func (service *TaxRatesService) Get(taxRateID string) (*TaxRate, *Response, error) {
+  return service.GetWithContext(context.Background(), taxRateID)
+}
+
+// Get a tax rate with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-tax-rate
+func (service *TaxRatesService) GetWithContext(ctx context.Context, taxRateID string) (*TaxRate, *Response, error) {
+  _url := "/taxes/" + taxRateID
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
+
+  taxRate := new(TaxRate)
+  response, err := service.client.Do(req, taxRate)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return taxRate, response, nil
+}
+
+// List tax rates. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-tax-rates
+func (service *TaxRatesService) List(opts *ListTaxRatesParams) (*[]TaxRate, *Response, error) {
+  return service.ListWithContext(context.Background(), opts)
+}
+
+// List tax rates with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-tax-rates
+func (service *TaxRatesService) ListWithContext(ctx context.Context, opts *ListTaxRatesParams) (*[]TaxRate, *Response, error) {
+  _url := "/taxes"
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
+
+  taxRates := new([]TaxRate)
+  response, err := service.client.Do(req, taxRates)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return taxRates, response, nil
+}
+
+// Update a tax rate. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-tax-rate
+func (service *TaxRatesService) Update(taxRateID string, taxRate *TaxRate) (*TaxRate, *Response, error) {
+  return service.UpdateWithContext(context.Background(), taxRateID, taxRate)
+}
+
+// Update a tax rate with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-tax-rate
+func (service *TaxRatesService) UpdateWithContext(ctx context.Context, taxRateID string, taxRate *TaxRate) (*TaxRate, *Response, error) {
+  _url := "/taxes/" + taxRateID
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, taxRate)
+
+  updatedTaxRate := new(TaxRate)
+  response, err := service.client.Do(req, updatedTaxRate)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return updatedTaxRate, response, nil
+}
+
+// Delete a tax rate. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-tax-rate
+func (service *TaxRatesService) Delete(taxRateID string, opts *DeleteTaxRateParams) (*TaxRate, *Response, error) {
+  return service.DeleteWithContext(context.Background(), taxRateID, opts)
+}
+
+// Delete a tax rate with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-tax-rate
+func (service *TaxRatesService) DeleteWithContext(ctx context.Context, taxRateID string, opts *DeleteTaxRateParams) (*TaxRate, *Response, error) {
+  _url := "/taxes/" + taxRateID
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
+
+  taxRate := new(TaxRate)
+  response, err := service.client.Do(req, taxRate)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return taxRate, response, nil
+}
+
+// Batch update tax rates. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-tax-rates
+func (service *TaxRatesService) Batch(opts *BatchTaxRateUpdate) (*BatchTaxRateUpdateResponse, *Response, error) {
+  return service.BatchWithContext(context.Background(), opts)
+}
+
+// Batch update tax rates with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-tax-rates
+func (service *TaxRatesService) BatchWithContext(ctx context.Context, opts *BatchTaxRateUpdate) (*BatchTaxRateUpdateResponse, *Response, error) {
+  _url := "/taxes/batch"
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, opts)
+
+  taxRates := new(BatchTaxRateUpdateResponse)
+  response, err := service.client.Do(req, taxRates)
+
+  if err != nil {
+    return nil, response, err
+  }
+
+  return taxRates, response, nil
+}
+
+// Paginate tax rates, fetching each page as it is iterated
+func (service *TaxRatesService) Paginate(opts *ListTaxRatesParams) *Paginator[TaxRate] {
+  params := ListTaxRatesParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]TaxRate, *Response, error) {
+    params.Page = page
+    taxRates, response, err := service.ListWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *taxRates, response, nil
+  })
+}
+
+// List all tax rates across every page
+func (service *TaxRatesService) ListAll(ctx context.Context, opts *ListTaxRatesParams) ([]TaxRate, error) {
+  return service.Paginate(opts).Collect(ctx)
+}
\ No newline at end of file
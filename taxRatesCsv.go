@@ -0,0 +1,215 @@
+package woocommerce
+
+import (
+  "context"
+  "encoding/csv"
+  "errors"
+  "fmt"
+  "io"
+  "strconv"
+  "strings"
+)
+
+// taxRateCSVHeader is the header row of the WooCommerce tax rates CSV (WooCommerce > Settings > Tax > Import / Export CSV)
+var taxRateCSVHeader = []string{"Country code", "State code", "Postcode / ZIP", "City", "Rate %", "Tax name", "Priority", "Compound", "Shipping", "Tax class"}
+
+const (
+  // taxRateCSVWildcard matches any country, state, postcode or city
+  taxRateCSVWildcard = "*"
+  // taxRateCSVListSeparator separates multiple postcodes or cities
+  taxRateCSVListSeparator = ";"
+)
+
+var errorTaxRateCSVEmpty = errors.New("tax rates csv is empty")
+
+// WriteTaxRatesCSV writes tax rates in the WooCommerce tax rates CSV format
+func WriteTaxRatesCSV(w io.Writer, taxRates []TaxRate) error {
+  writer := csv.NewWriter(w)
+
+  if err := writer.Write(taxRateCSVHeader); err != nil {
+    return err
+  }
+
+  for _, taxRate := range taxRates {
+    postcodes := taxRate.Postcodes
+    if len(postcodes) == 0 && taxRate.Postcode != "" {
+      postcodes = strings.Split(taxRate.Postcode, taxRateCSVListSeparator)
+    }
+
+    cities := taxRate.Cities
+    if len(cities) == 0 && taxRate.City != "" {
+      cities = strings.Split(taxRate.City, taxRateCSVListSeparator)
+    }
+
+    record := []string{
+      taxRateCSVValue(taxRate.Country),
+      taxRateCSVValue(taxRate.State),
+      taxRateCSVValue(strings.Join(postcodes, taxRateCSVListSeparator)),
+      taxRateCSVValue(strings.Join(cities, taxRateCSVListSeparator)),
+      taxRate.Rate,
+      taxRate.Name,
+      strconv.Itoa(taxRate.Priority),
+      taxRateCSVBool(taxRate.Compound, false),
+      taxRateCSVBool(taxRate.Shipping, true),
+      taxRate.Class,
+    }
+
+    if err := writer.Write(record); err != nil {
+      return err
+    }
+  }
+
+  writer.Flush()
+
+  return writer.Error()
+}
+
+// ReadTaxRatesCSV reads tax rates from the WooCommerce tax rates CSV format. The first row is
+// skipped as the header, like the WooCommerce importer does.
+func ReadTaxRatesCSV(r io.Reader) ([]TaxRate, error) {
+  reader := csv.NewReader(r)
+  reader.FieldsPerRecord = len(taxRateCSVHeader)
+  reader.TrimLeadingSpace = true
+
+  records, err := reader.ReadAll()
+  if err != nil {
+    return nil, err
+  }
+
+  if len(records) == 0 {
+    return nil, errorTaxRateCSVEmpty
+  }
+
+  taxRates := make([]TaxRate, 0, len(records)-1)
+
+  for i, record := range records[1:] {
+    priority := 1
+
+    if value := strings.TrimSpace(record[6]); value != "" {
+      priority, err = strconv.Atoi(value)
+      if err != nil {
+        return nil, fmt.Errorf("tax rates csv line %d: invalid priority %q", i+2, value)
+      }
+    }
+
+    class := strings.TrimSpace(record[9])
+    if strings.EqualFold(class, TaxClassStandard) {
+      class = ""
+    }
+
+    // Always send both flags, a missing shipping flag would apply the rate to shipping
+    compound := strings.TrimSpace(record[7]) == "1"
+    shipping := strings.TrimSpace(record[8]) == "1"
+
+    taxRates = append(taxRates, TaxRate{
+      Country:   strings.ToUpper(taxRateFromCSVValue(record[0])),
+      State:     strings.ToUpper(taxRateFromCSVValue(record[1])),
+      Postcodes: taxRateFromCSVList(record[2]),
+      Cities:    taxRateFromCSVList(record[3]),
+      Rate:      strings.TrimSpace(record[4]),
+      Name:      strings.TrimSpace(record[5]),
+      Priority:  priority,
+      Compound:  &compound,
+      Shipping:  &shipping,
+      Class:     class,
+    })
+  }
+
+  return taxRates, nil
+}
+
+// ExportCSV fetches every tax rate matching the params, and writes them in the WooCommerce tax rates CSV format
+func (service *TaxRatesService) ExportCSV(ctx context.Context, w io.Writer, opts *ListTaxRatesParams) error {
+  taxRates, err := service.ListAll(ctx, opts)
+  if err != nil {
+    return err
+  }
+
+  return WriteTaxRatesCSV(w, taxRates)
+}
+
+// ImportCSV reads tax rates from the WooCommerce tax rates CSV format, and creates them in batches of up to 100 rates.
+// Rates the API rejects are left out of the created rates, and returned in a *BatchError (Index is the rate position in the CSV).
+func (service *TaxRatesService) ImportCSV(ctx context.Context, r io.Reader) ([]TaxRate, error) {
+  taxRates, err := ReadTaxRatesCSV(r)
+  if err != nil {
+    return nil, err
+  }
+
+  createdTaxRates := []TaxRate{}
+  failures := []BatchFailure{}
+
+  for start := 0; start < len(taxRates); start += batchUpdateLimit {
+    end := min(start+batchUpdateLimit, len(taxRates))
+    batchRates := taxRates[start:end]
+
+    batch, _, err := service.BatchWithContext(ctx, &BatchTaxRateUpdate{Create: &batchRates})
+    if err != nil {
+      return createdTaxRates, err
+    }
+
+    if batch.Create == nil {
+      continue
+    }
+
+    for i, taxRate := range *batch.Create {
+      if taxRate.Error != nil {
+        failures = append(failures, BatchFailure{Index: start + i, Id: taxRate.Id, Err: taxRate.Error})
+      } else {
+        createdTaxRates = append(createdTaxRates, taxRate)
+      }
+    }
+  }
+
+  if len(failures) > 0 {
+    return createdTaxRates, &BatchError{Failures: failures}
+  }
+
+  return createdTaxRates, nil
+}
+
+// taxRateCSVValue returns the value, or the wildcard when it is empty
+func taxRateCSVValue(value string) string {
+  if value == "" {
+    return taxRateCSVWildcard
+  }
+
+  return value
+}
+
+// taxRateFromCSVValue returns the trimmed value, or an empty string for the wildcard
+func taxRateFromCSVValue(value string) string {
+  value = strings.TrimSpace(value)
+
+  if value == taxRateCSVWildcard {
+    return ""
+  }
+
+  return value
+}
+
+// taxRateFromCSVList splits a list of postcodes or cities, ignoring wildcards
+func taxRateFromCSVList(value string) []string {
+  values := []string{}
+
+  for _, item := range strings.Split(taxRateFromCSVValue(value), taxRateCSVListSeparator) {
+    if item = strings.TrimSpace(item); item != "" && item != taxRateCSVWildcard {
+      values = append(values, item)
+    }
+  }
+
+  return values
+}
+
+// taxRateCSVBool returns the CSV flag, using the WooCommerce default when the value is not set
+func taxRateCSVBool(value *bool, defaultValue bool) string {
+  if value != nil {
+    defaultValue = *value
+  }
+
+  if defaultValue {
+    return "1"
+  }
+
+  return "0"
+}
@@ -0,0 +1,105 @@
+package woocommerce
+
+import (
+  "bytes"
+  "reflect"
+  "testing"
+)
+
+func TestTaxRatesCSVRoundTrip(t *testing.T) {
+  yes, no := true, false
+
+  taxRates := []TaxRate{
+    {
+      Country:   "GB",
+      Postcodes: []string{},
+      Cities:    []string{},
+      Rate:      "20.0000",
+      Name:      "VAT",
+      Priority:  1,
+      Compound:  &no,
+      Shipping:  &yes,
+    },
+    {
+      Country:   "US",
+      State:     "CA",
+      Postcodes: []string{"90210", "90211", "902*"},
+      Cities:    []string{"Beverly Hills", "Los Angeles"},
+      Rate:      "7.2500",
+      Name:      "Sales",
+      Priority:  2,
+      Compound:  &yes,
+      Shipping:  &no,
+      Class:     "reduced-rate",
+    },
+    {
+      Country:   "",
+      Postcodes: []string{},
+      Cities:    []string{},
+      Rate:      "0.0000",
+      Name:      "",
+      Priority:  1,
+      Compound:  &no,
+      Shipping:  &no,
+      Class:     "zero-rate",
+    },
+  }
+
+  var written bytes.Buffer
+
+  if err := WriteTaxRatesCSV(&written, taxRates); err != nil {
+    t.Fatal(err)
+  }
+
+  read, err := ReadTaxRatesCSV(bytes.NewReader(written.Bytes()))
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  if !reflect.DeepEqual(read, taxRates) {
+    t.Errorf("ReadTaxRatesCSV(WriteTaxRatesCSV()) =\n%+v\nwant\n%+v", read, taxRates)
+  }
+
+  var rewritten bytes.Buffer
+
+  if err := WriteTaxRatesCSV(&rewritten, read); err != nil {
+    t.Fatal(err)
+  }
+
+  if rewritten.String() != written.String() {
+    t.Errorf("rewritten csv =\n%s\nwant\n%s", rewritten.String(), written.String())
+  }
+}
+
+func TestTaxRatesCSVDefaultFlags(t *testing.T) {
+  var written bytes.Buffer
+
+  // Unset flags are written with the WooCommerce defaults, and the legacy fields are split
+  err := WriteTaxRatesCSV(&written, []TaxRate{{Country: "DE", Postcode: "10115;10117", City: "Berlin", Rate: "19.0000", Name: "MwSt", Priority: 1}})
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  read, err := ReadTaxRatesCSV(&written)
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  if len(read) != 1 {
+    t.Fatalf("ReadTaxRatesCSV() = %d rates, want 1", len(read))
+  }
+
+  taxRate := read[0]
+
+  if *taxRate.Compound || !*taxRate.Shipping {
+    t.Errorf("flags = compound %v, shipping %v, want false and true", *taxRate.Compound, *taxRate.Shipping)
+  }
+
+  if want := []string{"10115", "10117"}; !reflect.DeepEqual(taxRate.Postcodes, want) {
+    t.Errorf("Postcodes = %v, want %v", taxRate.Postcodes, want)
+  }
+
+  if want := []string{"Berlin"}; !reflect.DeepEqual(taxRate.Cities, want) {
+    t.Errorf("Cities = %v, want %v", taxRate.Cities, want)
+  }
+}
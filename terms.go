@@ -0,0 +1,44 @@
+package woocommerce
+
+import (
+  "context"
+  "errors"
+  "html"
+  "strconv"
+  "strings"
+)
+
+// matchTermName checks if a term name (as returned by the API, HTML escaped) matches the given name, ignoring case
+func matchTermName(termName string, name string) bool {
+  return strings.EqualFold(strings.TrimSpace(html.UnescapeString(termName)), strings.TrimSpace(name))
+}
+
+// findTerm returns the first term matching, or nil
+func findTerm[T any](terms []T, match func(term T) bool) *T {
+  for i := range terms {
+    if match(terms[i]) {
+      return &terms[i]
+    }
+  }
+
+  return nil
+}
+
+// ensureTerm returns the term found, or creates it. When the store reports the term
+// already exists (eg. created concurrently), the existing term is fetched instead.
+func ensureTerm[T any](ctx context.Context, find func(ctx context.Context) (*T, error), create func(ctx context.Context) (*T, error), get func(ctx context.Context, termID string) (*T, error)) (*T, error) {
+  term, err := find(ctx)
+  if err != nil || term != nil {
+    return term, err
+  }
+
+  createdTerm, err := create(ctx)
+
+  var apiError *APIError
+
+  if errors.As(err, &apiError) && apiError.Code == errorCodeTermExists && apiError.Data.ResourceID != 0 {
+    return get(ctx, strconv.Itoa(apiError.Data.ResourceID))
+  }
+
+  return createdTerm, err
+}
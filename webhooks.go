@@ -1,7 +1,7 @@
 package woocommerce
 
 import (
-  "net/http"
+  "context"
 )
 
 // Webhooks service
@@ -55,9 +55,14 @@ type BatchWebhookUpdateResponse struct {
 }
 
 // Create a webhook. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-webhook
-func (service *WebhookService) Create(webhook *Webhook) (*Webhook, *http.Response, error) {
+func (service *WebhookService) Create(webhook *Webhook) (*Webhook, *Response, error) {
+  return service.CreateWithContext(context.Background(), webhook)
+}
+
+// Create a webhook with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#create-a-webhook
+func (service *WebhookService) CreateWithContext(ctx context.Context, webhook *Webhook) (*Webhook, *Response, error) {
   _url := "/webhooks" 
-  req, _ := service.client.NewRequest("POST", _url, nil, webhook)
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, webhook)
 
   createdWebhook := new(Webhook)
   response, err := service.client.Do(req, createdWebhook)
@@ -70,9 +75,14 @@ func (service *WebhookService) Create(webhook *Webhook) (*Webhook, *http.Respons
 }
 
 // Get a wehook. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-webhook
-func (service *WebhookService) Get(webhookID string) (*Webhook, *http.Response, error) {
+func (service *WebhookService) Get(webhookID string) (*Webhook, *Response, error) {
+  return service.GetWithContext(context.Background(), webhookID)
+}
+
+// Get a wehook with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#retrieve-a-webhook
+func (service *WebhookService) GetWithContext(ctx context.Context, webhookID string) (*Webhook, *Response, error) {
   _url := "/webhooks/" + webhookID
-  req, _ := service.client.NewRequest("GET", _url, nil, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, nil, nil)
 
   webhook := new(Webhook)
   response, err := service.client.Do(req, webhook)
@@ -85,9 +95,14 @@ func (service *WebhookService) Get(webhookID string) (*Webhook, *http.Response,
 }
 
 // List Webhooks. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-webhooks
-func (service *WebhookService) List(opts *ListWebhooksParams) (*[]Webhook,  *http.Response, error) {
+func (service *WebhookService) List(opts *ListWebhooksParams) (*[]Webhook,  *Response, error) {
+  return service.ListWithContext(context.Background(), opts)
+}
+
+// List Webhooks with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#list-all-webhooks
+func (service *WebhookService) ListWithContext(ctx context.Context, opts *ListWebhooksParams) (*[]Webhook,  *Response, error) {
   _url := "/webhooks"
-  req, _ := service.client.NewRequest("GET", _url, opts, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "GET", _url, opts, nil)
 
   webhooks := new([]Webhook)
   response, err := service.client.Do(req, webhooks)
@@ -100,9 +115,14 @@ func (service *WebhookService) List(opts *ListWebhooksParams) (*[]Webhook,  *htt
 }
 
 // Update a webhook. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-webhook
-func (service *WebhookService) Update(webhookID string, webhook *Webhook) (*Webhook, *http.Response, error) {
+func (service *WebhookService) Update(webhookID string, webhook *Webhook) (*Webhook, *Response, error) {
+  return service.UpdateWithContext(context.Background(), webhookID, webhook)
+}
+
+// Update a webhook with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#update-a-webhook
+func (service *WebhookService) UpdateWithContext(ctx context.Context, webhookID string, webhook *Webhook) (*Webhook, *Response, error) {
   _url := "/webhooks/" + webhookID
-  req, _ := service.client.NewRequest("PUT", _url, nil, webhook)
+  req, _ := service.client.NewRequestWithContext(ctx, "PUT", _url, nil, webhook)
 
   updatedWebhook := new(Webhook)
   response, err := service.client.Do(req, updatedWebhook)
@@ -115,9 +135,14 @@ func (service *WebhookService) Update(webhookID string, webhook *Webhook) (*Webh
 }
 
 // Delete a webhook. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-webhook
-func (service *WebhookService) Delete(webhookID string, opts *DeleteWebhookParams) (*Webhook, *http.Response, error) {
+func (service *WebhookService) Delete(webhookID string, opts *DeleteWebhookParams) (*Webhook, *Response, error) {
+  return service.DeleteWithContext(context.Background(), webhookID, opts)
+}
+
+// Delete a webhook with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#delete-a-webhook
+func (service *WebhookService) DeleteWithContext(ctx context.Context, webhookID string, opts *DeleteWebhookParams) (*Webhook, *Response, error) {
   _url := "/webhooks/" + webhookID
-  req, _ := service.client.NewRequest("DELETE", _url, opts, nil)
+  req, _ := service.client.NewRequestWithContext(ctx, "DELETE", _url, opts, nil)
 
   webhook := new(Webhook)
   response, err := service.client.Do(req, webhook)
@@ -130,9 +155,14 @@ func (service *WebhookService) Delete(webhookID string, opts *DeleteWebhookParam
 }
 
 // Batch update webhooks. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-webhooks
-func (service *WebhookService) Batch(opts *BatchWebhookUpdate) (*BatchWebhookUpdateResponse, *http.Response, error) {
+func (service *WebhookService) Batch(opts *BatchWebhookUpdate) (*BatchWebhookUpdateResponse, *Response, error) {
+  return service.BatchWithContext(context.Background(), opts)
+}
+
+// Batch update webhooks with context. Reference: https://woocommerce.github.io/woocommerce-rest-api-docs/#batch-update-webhooks
+func (service *WebhookService) BatchWithContext(ctx context.Context, opts *BatchWebhookUpdate) (*BatchWebhookUpdateResponse, *Response, error) {
   _url := "/webhooks/batch"
-  req, _ := service.client.NewRequest("POST", _url, nil, opts)
+  req, _ := service.client.NewRequestWithContext(ctx, "POST", _url, nil, opts)
 
   webhooks := new(BatchWebhookUpdateResponse)
   response, err := service.client.Do(req, webhooks)
@@ -142,4 +172,29 @@ func (service *WebhookService) Batch(opts *BatchWebhookUpdate) (*BatchWebhookUpd
   }
 
   return webhooks, response, nil
+}
+
+// Paginate webhooks, fetching each page as it is iterated
+func (service *WebhookService) Paginate(opts *ListWebhooksParams) *Paginator[Webhook] {
+  params := ListWebhooksParams{}
+
+  if opts != nil {
+    params = *opts
+  }
+
+  return newPaginator(params.Page, func(ctx context.Context, page int) ([]Webhook, *Response, error) {
+    params.Page = page
+    webhooks, response, err := service.ListWithContext(ctx, &params)
+
+    if err != nil {
+      return nil, response, err
+    }
+
+    return *webhooks, response, nil
+  })
+}
+
+// List all webhooks across every page
+func (service *WebhookService) ListAll(ctx context.Context, opts *ListWebhooksParams) ([]Webhook, error) {
+  return service.Paginate(opts).Collect(ctx)
 }
\ No newline at end of file
@@ -2,12 +2,10 @@ package woocommerce
 
 import (
   "bytes"
-  "encoding/base64"
+  "context"
   "encoding/json"
   "errors"
-  "fmt"
   "io"
-  "io/ioutil"
   "net/http"
   "net/url"
   "strings"
@@ -18,11 +16,11 @@ import (
 
 const (
   defaultRestEndpointVersion   = "v3"
-  defaultHeaderName            = "Authorization"
   acceptedContentType          = "application/json"
-  userAgent                    = "go-woocommerce-api/1.1"
-  clientRequestRetryAttempts   = 2
-  clientRequestRetryHoldMillis = 1000
+  defaultUserAgent             = "go-woocommerce-api/1.1"
+  defaultRetryMaxAttempts      = 2
+  defaultRetryMinBackoff       = 1000 * time.Millisecond
+  defaultRetryMaxBackoff       = 30 * time.Second
 )
 
 var errorDoAllAttemptsExhausted = errors.New("all request attempts were exhausted")
@@ -32,71 +30,101 @@ type ClientConfig struct {
   HttpClient          *http.Client
   RestEndpointURL     string
   RestEndpointVersion string
-}
-
-type auth struct {
-  HeaderName string
-  ApiKey     string
+  RetryPolicy         *RetryPolicy
+  Authenticator       Authenticator
+  UserAgent           string
+  Timeout             time.Duration
+  BaseHeaders         http.Header
 }
 
 type Client struct {
   config *ClientConfig
   client *http.Client
-  auth *auth
   baseURL *url.URL
 
-  Coupons       *CouponsService
-  Customers     *CustomersService
-  Orders        *OrdersService
-  OrderNotes    *OrderNotesService
-  Refunds       *RefundsService
-  Products      *ProductsService
-  Webhooks      *WebhookService
+  Coupons                *CouponsService
+  Customers              *CustomersService
+  Orders                 *OrdersService
+  OrderNotes             *OrderNotesService
+  Refunds                *RefundsService
+  Products               *ProductsService
+  ProductVariations      *ProductVariationsService
+  ProductCategories      *ProductCategoriesService
+  ProductTags            *ProductTagsService
+  ShippingClasses        *ShippingClassesService
+  ProductAttributes      *ProductAttributesService
+  ProductAttributeTerms  *ProductAttributeTermsService
+  ProductReviews         *ProductReviewsService
+  Reports                *ReportsService
+  TaxRates               *TaxRatesService
+  TaxClasses             *TaxClassesService
+  ShippingZones          *ShippingZonesService
+  ShippingZoneLocations  *ShippingZoneLocationsService
+  ShippingZoneMethods    *ShippingZoneMethodsService
+  Settings               *SettingsService
+  PaymentGateways        *PaymentGatewaysService
+  SystemStatus           *SystemStatusService
+  SystemStatusTools      *SystemStatusToolsService
+  Data                   *DataService
+  Analytics              *AnalyticsService
+  Subscriptions          *SubscriptionsService
+  SubscriptionNotes      *SubscriptionNotesService
+  Webhooks               *WebhookService
 }
 
 type service struct {
   client *Client
 }
 
-type errorResponse struct {
-  Response *http.Response
-
-  Code    string    `json:"code"`
-  Message string    `json:"message"`
-  Data    ErrorData `json:"data"`
-}
+// New creates a client for the given store URL, configured with the given options
+func New(shopURL string, opts ...ClientOption) (*Client, error) {
+  config := ClientConfig{RestEndpointURL: shopURL}
 
-type ErrorData struct {
-  Status int `json:"status"`
-}
+  for _, opt := range opts {
+    opt(&config)
+  }
 
-func (response *errorResponse) Error() string {
-  return fmt.Sprintf("%v %v: %d %v",
-    response.Response.Request.Method, response.Response.Request.URL,
-    response.Response.StatusCode, response.Message)
+  return NewWithConfig(config)
 }
 
-func New(shopURL string) (*Client, error) {
-  if shopURL == "" {
+// NewWithConfig creates a client from the given configuration, using defaults for unset fields
+func NewWithConfig(config ClientConfig) (*Client, error) {
+  if config.RestEndpointURL == "" {
     return nil, errors.New("store url is required")
   }
 
-  config := ClientConfig{
-    HttpClient: http.DefaultClient,
+  if config.HttpClient == nil {
+    config.HttpClient = http.DefaultClient
+  }
+
+  if config.RestEndpointVersion == "" {
+    config.RestEndpointVersion = defaultRestEndpointVersion
+  }
+
+  if config.RetryPolicy == nil {
+    config.RetryPolicy = DefaultRetryPolicy()
   }
 
-  config.HttpClient = http.DefaultClient
-  config.RestEndpointURL = shopURL
-  config.RestEndpointVersion = defaultRestEndpointVersion
+  if config.UserAgent == "" {
+    config.UserAgent = defaultUserAgent
+  }
+
+  // Apply timeout to a copy? (never alter a shared client, eg. http.DefaultClient)
+  if config.Timeout > 0 {
+    httpClient := *config.HttpClient
+    httpClient.Timeout = config.Timeout
+
+    config.HttpClient = &httpClient
+  }
 
-  // Create client
-  baseURL, err := url.Parse(config.RestEndpointURL + "/wp-json/wc/" + defaultRestEndpointVersion)
+  // Create client (request paths are resolved against the base URL, prefixed by the namespace)
+  baseURL, err := url.Parse(strings.TrimRight(config.RestEndpointURL, "/") + "/wp-json/")
 
   if err != nil {
     return nil, err
   }
 
-  client := &Client{config: &config, client: config.HttpClient, auth: &auth{}, baseURL: baseURL}
+  client := &Client{config: &config, client: config.HttpClient, baseURL: baseURL}
 
   // Map services
   client.Coupons = &CouponsService{client: client}
@@ -105,6 +133,27 @@ func New(shopURL string) (*Client, error) {
   client.OrderNotes = &OrderNotesService{client: client}
   client.Refunds = &RefundsService{client: client}
   client.Products = &ProductsService{client: client}
+  client.ProductVariations = &ProductVariationsService{client: client}
+  client.ProductCategories = &ProductCategoriesService{client: client}
+  client.ProductTags = &ProductTagsService{client: client}
+  client.ShippingClasses = &ShippingClassesService{client: client}
+  client.ProductAttributes = &ProductAttributesService{client: client}
+  client.ProductAttributeTerms = &ProductAttributeTermsService{client: client}
+  client.ProductReviews = &ProductReviewsService{client: client}
+  client.Reports = &ReportsService{client: client}
+  client.TaxRates = &TaxRatesService{client: client}
+  client.TaxClasses = &TaxClassesService{client: client}
+  client.ShippingZones = &ShippingZonesService{client: client}
+  client.ShippingZoneLocations = &ShippingZoneLocationsService{client: client}
+  client.ShippingZoneMethods = &ShippingZoneMethodsService{client: client}
+  client.Settings = &SettingsService{client: client}
+  client.PaymentGateways = &PaymentGatewaysService{client: client}
+  client.SystemStatus = &SystemStatusService{client: client}
+  client.SystemStatusTools = &SystemStatusToolsService{client: client}
+  client.Data = &DataService{client: client}
+  client.Analytics = &AnalyticsService{client: client}
+  client.Subscriptions = &SubscriptionsService{client: client}
+  client.SubscriptionNotes = &SubscriptionNotesService{client: client}
   client.Webhooks = &WebhookService{client: client}
 
   return client, nil
@@ -112,19 +161,55 @@ func New(shopURL string) (*Client, error) {
 
 // Authenticate saves authenitcation parameters for user
 func (client *Client) Authenticate(consumer_key string, consumer_secret string) {
-  client.auth.HeaderName = defaultHeaderName
+  // Store is not served over HTTPS? (WooCommerce requires OAuth 1.0a signed requests)
+  if client.baseURL.Scheme == "http" {
+    client.SetAuthenticator(&OAuth1Auth{ConsumerKey: consumer_key, ConsumerSecret: consumer_secret, SignatureMethod: OAuthSignatureHMACSHA256})
 
-  client.auth.ApiKey = "Basic " + base64.StdEncoding.EncodeToString([]byte(strings.Join([]string{consumer_key, consumer_secret}, ":")))
+    return
+  }
+
+  client.SetAuthenticator(&BasicAuth{ConsumerKey: consumer_key, ConsumerSecret: consumer_secret})
+}
+
+// SetAuthenticator sets the strategy used to authenticate requests (eg. query string keys, application passwords or bearer tokens)
+func (client *Client) SetAuthenticator(authenticator Authenticator) {
+  client.config.Authenticator = authenticator
+}
+
+// SetOAuthSignatureMethod sets the OAuth 1.0a signature method (HMAC-SHA1 or HMAC-SHA256) used for non-HTTPS stores
+func (client *Client) SetOAuthSignatureMethod(signatureMethod string) {
+  if oauth, ok := client.config.Authenticator.(*OAuth1Auth); ok {
+    oauth.SignatureMethod = signatureMethod
+  }
+}
+
+// SetRetryPolicy sets the policy used to retry failed requests (nil disables retries)
+func (client *Client) SetRetryPolicy(policy *RetryPolicy) {
+  if policy == nil {
+    policy = NoRetryPolicy()
+  }
+
+  client.config.RetryPolicy = policy
 }
 
 // NewRequest creates an API request
 func (client *Client) NewRequest(method, urlStr string, opts interface{}, body interface{}) (*http.Request, error) {
-  // Append Query Params to URL
+  return client.NewRequestWithContext(context.Background(), method, urlStr, opts, body)
+}
+
+// NewRequestWithContext creates an API request bound to the given context
+func (client *Client) NewRequestWithContext(ctx context.Context, method, urlStr string, opts interface{}, body interface{}) (*http.Request, error) {
+  // Append Query Params to URL (from a params struct, or url.Values)
   if opts != nil {
-    queryParams, err := query.Values(opts)
+    queryParams, ok := opts.(url.Values)
 
-    if err != nil {
-      return nil, err
+    if !ok {
+      var err error
+
+      queryParams, err = query.Values(opts)
+      if err != nil {
+        return nil, err
+      }
     }
 
     rawQuery := queryParams.Encode()
@@ -134,59 +219,120 @@ func (client *Client) NewRequest(method, urlStr string, opts interface{}, body i
     }
   }
 
-  rel, err := url.Parse(client.config.RestEndpointVersion + urlStr)
+  rel, err := url.Parse(client.namespace(ctx) + urlStr)
   if err != nil {
     return nil, err
   }
 
   url := client.baseURL.ResolveReference(rel)
 
-  var buf io.ReadWriter
+  // Buffer body, so it can be replayed on retry (a *bytes.Reader sets req.GetBody)
+  var buf io.Reader
   if body != nil {
-    buf = new(bytes.Buffer)
+    encoded := new(bytes.Buffer)
 
-    err := json.NewEncoder(buf).Encode(body)
+    err := json.NewEncoder(encoded).Encode(body)
     if err != nil {
       return nil, err
     }
+
+    buf = bytes.NewReader(encoded.Bytes())
   }
 
-  req, err := http.NewRequest(method, url.String(), buf)
+  req, err := http.NewRequestWithContext(ctx, method, url.String(), buf)
   if err != nil {
     return nil, err
   }
 
-  req.Header.Add(client.auth.HeaderName, client.auth.ApiKey)
   req.Header.Add("Accept", acceptedContentType)
   req.Header.Add("Content-type", acceptedContentType)
-  req.Header.Add("User-Agent", userAgent)
+  req.Header.Add("User-Agent", client.config.UserAgent)
+
+  // Add base headers (these may override the defaults above)
+  for name, values := range client.config.BaseHeaders {
+    req.Header.Del(name)
+
+    for _, value := range values {
+      req.Header.Add(name, value)
+    }
+  }
 
   return req, nil
 }
 
-// Do sends an API request
-func (client *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
+// DoWithContext sends an API request bound to the given context
+func (client *Client) DoWithContext(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+  if req == nil {
+    return nil, errorDoAttemptNilRequest
+  }
+
+  return client.Do(req.WithContext(ctx), v)
+}
+
+// Do sends an API request. The request context is honoured while waiting between attempts.
+func (client *Client) Do(req *http.Request, v interface{}) (*Response, error) {
+  if req == nil {
+    return nil, errorDoAttemptNilRequest
+  }
+
   var lastErr error
+  var wait time.Duration
+  var refreshed bool
 
+  policy := client.retryPolicy()
   attempts := 0
 
-  for attempts < clientRequestRetryAttempts {
+  // Make body replayable (for retries, or after refreshing credentials)
+  if err := bufferRequestBody(req); err != nil {
+    return nil, err
+  }
+
+  // Authenticate here rather than when the request is created, so authenticator errors
+  // (eg. a failed token refresh) are returned to the caller
+  if err := client.authenticate(req); err != nil {
+    return nil, err
+  }
+
+  for attempts < policy.attempts() {
     // Hold before this attempt? (ie. not first attempt)
     if attempts > 0 {
-      time.Sleep(clientRequestRetryHoldMillis * time.Millisecond)
+      if err := sleepWithContext(req.Context(), wait); err != nil {
+        return nil, err
+      }
+
+      // Rewind body, and authenticate again (eg. OAuth 1.0a nonces cannot be reused)
+      if err := client.prepareRetry(req); err != nil {
+        return nil, err
+      }
     }
 
     // Dispatch request attempt
     attempts++
-    resp, shouldRetry, err := client.doAttempt(req, v)
+    resp, shouldRetry, err := client.doAttempt(req, v, policy, attempts < policy.attempts())
+
+    // Credentials rejected? (refresh them once, and send again)
+    if refresher, ok := client.config.Authenticator.(credentialsRefresher); ok && !refreshed && IsUnauthorized(err) {
+      refreshed = true
+
+      if refreshErr := refresher.RefreshCredentials(req.Context()); refreshErr != nil {
+        return newResponse(resp), errors.Join(err, refreshErr)
+      }
+
+      if err := client.prepareRetry(req); err != nil {
+        return nil, err
+      }
+
+      resp, shouldRetry, err = client.doAttempt(req, v, policy, attempts < policy.attempts())
+    }
 
     // Return response straight away? (we are done)
     if !shouldRetry {
-      return resp, err
+      return newResponse(resp), err
     }
 
-    // Should retry: store last error (we are not done)
+    // Should retry: store last error and backoff (we are not done)
     lastErr = err
+    wait = policy.backoff(attempts, resp)
   }
 
   // Set default error? (all attempts failed, but no error is set)
@@ -198,15 +344,21 @@ func (client *Client) Do(req *http.Request, v interface{}) (*http.Response, erro
   return nil, lastErr
 }
 
-func (client *Client) doAttempt(req *http.Request, v interface{}) (*http.Response, bool, error) {
-  if req == nil {
-    return nil, false, errorDoAttemptNilRequest
-  }
-
+func (client *Client) doAttempt(req *http.Request, v interface{}, policy *RetryPolicy, canRetry bool) (*http.Response, bool, error) {
   resp, err := client.client.Do(req)
 
-  if checkRequestRetry(resp, err) {
-    return nil, true, err
+  if canRetry && policy.shouldRetry(req, resp, err) {
+    // Release connection, keeping the response headers for the backoff
+    if resp != nil {
+      io.Copy(io.Discard, resp.Body)
+      resp.Body.Close()
+    }
+
+    return resp, true, err
+  }
+
+  if err != nil {
+    return nil, false, err
   }
 
   defer resp.Body.Close()
@@ -230,6 +382,85 @@ func (client *Client) doAttempt(req *http.Request, v interface{}) (*http.Respons
   return resp, false, err
 }
 
+// authenticate adds the configured credentials to the request
+func (client *Client) authenticate(req *http.Request) error {
+  if client.config.Authenticator == nil {
+    return nil
+  }
+
+  return client.config.Authenticator.Authenticate(req)
+}
+
+// prepareRetry makes the request ready to be sent again
+func (client *Client) prepareRetry(req *http.Request) error {
+  if err := rewindRequestBody(req); err != nil {
+    return err
+  }
+
+  return client.authenticate(req)
+}
+
+// retryPolicy returns the configured retry policy, or the default one
+func (client *Client) retryPolicy() *RetryPolicy {
+  if client.config.RetryPolicy == nil {
+    return DefaultRetryPolicy()
+  }
+
+  return client.config.RetryPolicy
+}
+
+// bufferRequestBody reads a non-replayable request body into memory, so it can be sent again
+func bufferRequestBody(req *http.Request) error {
+  if req.Body == nil || req.Body == http.NoBody || req.GetBody != nil {
+    return nil
+  }
+
+  data, err := io.ReadAll(req.Body)
+  req.Body.Close()
+
+  if err != nil {
+    return err
+  }
+
+  req.ContentLength = int64(len(data))
+  req.GetBody = func() (io.ReadCloser, error) {
+    return io.NopCloser(bytes.NewReader(data)), nil
+  }
+
+  req.Body, _ = req.GetBody()
+
+  return nil
+}
+
+// rewindRequestBody resets the request body before sending the request again
+func rewindRequestBody(req *http.Request) error {
+  if req.GetBody == nil {
+    return nil
+  }
+
+  body, err := req.GetBody()
+  if err != nil {
+    return err
+  }
+
+  req.Body = body
+
+  return nil
+}
+
+// sleepWithContext waits for the given duration, or until the context is done
+func sleepWithContext(ctx context.Context, duration time.Duration) error {
+  timer := time.NewTimer(duration)
+  defer timer.Stop()
+
+  select {
+  case <-ctx.Done():
+    return ctx.Err()
+  case <-timer.C:
+    return nil
+  }
+}
+
 // checkRequestRetry checks if should retry request
 func checkRequestRetry(response *http.Response, err error) bool {
   // Low-level error, or response status is a server error? (HTTP 5xx)
@@ -249,12 +480,17 @@ func checkResponse(response *http.Response) error {
   }
 
   // Map response error data (eg. HTTP 4xx)
-  errorResponse := &errorResponse{Response: response}
+  apiError := &APIError{Response: response}
 
-  data, err := ioutil.ReadAll(response.Body)
+  data, err := io.ReadAll(response.Body)
   if err == nil && data != nil {
-    json.Unmarshal(data, errorResponse)
+    json.Unmarshal(data, apiError)
+  }
+
+  // Fill status from response? (eg. error body is not JSON)
+  if apiError.Data.Status == 0 {
+    apiError.Data.Status = response.StatusCode
   }
 
-  return errorResponse
+  return apiError
 }
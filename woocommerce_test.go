@@ -0,0 +1,129 @@
+package woocommerce
+
+import (
+  "io"
+  "net/http"
+  "net/http/httptest"
+  "sync"
+  "testing"
+  "time"
+)
+
+// recordingServer fails the first attempts with the given status, then answers with the body
+func recordingServer(t *testing.T, failures int, status int, body string) (*httptest.Server, func() []string) {
+  var mutex sync.Mutex
+  var bodies []string
+
+  server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+    data, err := io.ReadAll(r.Body)
+    if err != nil {
+      t.Errorf("reading request body: %v", err)
+    }
+
+    mutex.Lock()
+    bodies = append(bodies, string(data))
+    attempt := len(bodies)
+    mutex.Unlock()
+
+    if attempt <= failures {
+      w.Header().Set("Retry-After", "0")
+      w.WriteHeader(status)
+
+      return
+    }
+
+    w.Write([]byte(body))
+  }))
+
+  t.Cleanup(server.Close)
+
+  return server, func() []string {
+    mutex.Lock()
+    defer mutex.Unlock()
+
+    return append([]string{}, bodies...)
+  }
+}
+
+func TestBatchRetryResendsIdenticalBody(t *testing.T) {
+  tests := []struct {
+    name     string
+    status   int
+    failures int
+    policy   *RetryPolicy
+  }{
+    {
+      name:     "rate limited",
+      status:   http.StatusTooManyRequests,
+      failures: 1,
+      policy:   DefaultRetryPolicy(),
+    },
+    {
+      name:     "server error with POST retries allowed",
+      status:   http.StatusBadGateway,
+      failures: 2,
+      policy:   &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, RetryMethods: []string{"POST"}},
+    },
+  }
+
+  for _, test := range tests {
+    t.Run(test.name, func(t *testing.T) {
+      server, bodies := recordingServer(t, test.failures, test.status, `{"create":[{"id":7,"name":"Hoodie"}]}`)
+
+      client, err := New(server.URL, WithRetryPolicy(test.policy))
+      if err != nil {
+        t.Fatal(err)
+      }
+
+      client.Authenticate("ck_test", "cs_test")
+
+      batch, _, err := client.Products.Batch(&BatchProductUpdate{
+        Create: &[]Product{{Name: "Hoodie", RegularPrice: "45.00"}},
+        Delete: &[]int{12, 13},
+      })
+
+      if err != nil {
+        t.Fatalf("Batch() error = %v", err)
+      }
+
+      if batch.Create == nil || (*batch.Create)[0].Id != 7 {
+        t.Fatalf("Batch() create = %+v, want product 7", batch.Create)
+      }
+
+      sent := bodies()
+
+      if len(sent) != test.failures+1 {
+        t.Fatalf("attempts = %d, want %d", len(sent), test.failures+1)
+      }
+
+      if sent[0] == "" {
+        t.Fatal("first attempt sent an empty body")
+      }
+
+      for i, body := range sent[1:] {
+        if body != sent[0] {
+          t.Errorf("attempt %d body = %q, want %q", i+2, body, sent[0])
+        }
+      }
+    })
+  }
+}
+
+func TestBatchIsNotRetriedOnServerErrorByDefault(t *testing.T) {
+  server, bodies := recordingServer(t, 1, http.StatusInternalServerError, `{}`)
+
+  client, err := New(server.URL, WithRetryPolicy(&RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, RetryMethods: DefaultRetryPolicy().RetryMethods}))
+  if err != nil {
+    t.Fatal(err)
+  }
+
+  _, _, err = client.Products.Batch(&BatchProductUpdate{Delete: &[]int{12}})
+
+  if err == nil {
+    t.Fatal("Batch() error = nil, want the server error")
+  }
+
+  if sent := bodies(); len(sent) != 1 {
+    t.Fatalf("attempts = %d, want 1 (POST is not retried on 5xx)", len(sent))
+  }
+}